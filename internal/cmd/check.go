@@ -2,7 +2,9 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/spf13/cobra"
@@ -29,6 +31,16 @@ func NewCheckCmd(app *stylist.App) *cobra.Command {
 	addOutputFlags(cmd, &app.Config.Output)
 	addProcessorFilterFlags(cmd, action.ProcessorFilter)
 
+	// Only check supports stdin: fix commands (and suggested fixes)
+	// rewrite files on disk, so there would be nowhere to write the
+	// fixed contents of an unsaved buffer.
+	cmd.Flags().StringVar(
+		&action.StdinPath,
+		"stdin-path",
+		action.StdinPath,
+		"Check the contents of stdin as the file at this path (e.g. an unsaved editor buffer)",
+	)
+
 	return cmd
 }
 
@@ -43,11 +55,18 @@ type CheckAction struct {
 	*stylist.App
 
 	ProcessorFilter *stylist.ProcessorFilter
+	StdinPath       string
 
 	pathSpecs []string
 }
 
 func (a *CheckAction) Validate(args []string) error {
+	if a.StdinPath != "" {
+		if len(args) > 0 {
+			return errors.New("paths can not be combined with --stdin-path")
+		}
+		args = []string{a.StdinPath}
+	}
 	a.pathSpecs = args
 	if len(a.pathSpecs) == 0 {
 		a.pathSpecs = []string{"."}
@@ -64,6 +83,16 @@ func (a *CheckAction) Run(ctx context.Context) error {
 	pipeline := stylist.NewPipeline(processors, excludes)
 
 	cwd, _ := os.Getwd()
+	if a.StdinPath != "" {
+		content, err := io.ReadAll(a.IO.In)
+		if err != nil {
+			return fmt.Errorf("unable to read stdin: %w", err)
+		}
+		ctx = stylist.WithStdinBuffer(ctx, &stylist.StdinBuffer{
+			Path:    a.StdinPath,
+			Content: content,
+		})
+	}
 	results, err := pipeline.Check(ctx, cwd, a.pathSpecs)
 	if err != nil {
 		return err
@@ -73,10 +102,14 @@ func (a *CheckAction) Run(ctx context.Context) error {
 		a.Logger.Debug(fmt.Sprintf("%#v", result))
 	}
 
-	err = stylist.NewResultPrinter(a.IO, a.Config).Print(results)
+	run := pipeline.RunInfo()
+	run.StartedAt = a.StartedAt
+	err = stylist.PrintResults(a.IO, a.Config, results, run)
 	if err != nil {
 		return err
 	}
 
-	return stylist.NewResultsError(results)
+	return stylist.NewThresholdResultsError(
+		results, a.Config.Output.FailLevel, a.Config.Output.MaxWarnings,
+	)
 }
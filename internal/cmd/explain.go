@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/twelvelabs/stylist/internal/stylist"
+)
+
+func NewExplainCmd(app *stylist.App) *cobra.Command {
+	action := NewExplainAction(app)
+
+	cmd := &cobra.Command{
+		Use:   "explain [flags] SOURCE/RULE_ID",
+		Short: "Show the name, description and URI of a processor rule",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := action.Validate(args); err != nil {
+				return err
+			}
+			return action.Run(cmd.Context())
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	return cmd
+}
+
+func NewExplainAction(app *stylist.App) *ExplainAction {
+	return &ExplainAction{
+		App: app,
+	}
+}
+
+type ExplainAction struct {
+	*stylist.App
+
+	source string
+	ruleID string
+}
+
+func (a *ExplainAction) Validate(args []string) error {
+	source, ruleID, ok := strings.Cut(args[0], "/")
+	if !ok || source == "" || ruleID == "" {
+		return fmt.Errorf("invalid rule %q: expected SOURCE/RULE_ID", args[0])
+	}
+	a.source = source
+	a.ruleID = ruleID
+	return nil
+}
+
+func (a *ExplainAction) Run(ctx context.Context) error {
+	filter := &stylist.ProcessorFilter{Names: []string{a.source}}
+	processors, err := filter.Filter(a.Config.Processors)
+	if err != nil {
+		return err
+	}
+
+	explanation, err := processors[0].ExplainRule(ctx, a.ruleID)
+	if err != nil {
+		return err
+	}
+
+	out := a.IO.Out
+	fmt.Fprintf(out, "%s\n", a.IO.Formatter().Bold(explanation.Source+"/"+explanation.Rule.ID))
+	fmt.Fprintf(out, "  Name: %s\n", explanation.Rule.Name)
+	if explanation.Rule.URI != "" {
+		fmt.Fprintf(out, "  URI: %s\n", explanation.Rule.URI)
+	}
+	if explanation.Rule.Description != "" {
+		fmt.Fprintf(out, "\n%s\n", explanation.Rule.Description)
+	}
+
+	return nil
+}
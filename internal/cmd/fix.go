@@ -29,6 +29,20 @@ func NewFixCmd(app *stylist.App) *cobra.Command {
 	addOutputFlags(cmd, &app.Config.Output)
 	addProcessorFilterFlags(cmd, action.ProcessorFilter)
 
+	cmd.Flags().BoolVar(
+		&action.ApplySuggestions,
+		"apply-suggestions",
+		action.ApplySuggestions,
+		"Apply the fixes suggested in check command output",
+	)
+	cmd.Flags().BoolVarP(
+		&action.Interactive,
+		"interactive",
+		"i",
+		action.Interactive,
+		"Review and accept each change before it is kept",
+	)
+
 	return cmd
 }
 
@@ -42,7 +56,9 @@ func NewFixAction(app *stylist.App) *FixAction {
 type FixAction struct {
 	*stylist.App
 
-	ProcessorFilter *stylist.ProcessorFilter
+	ProcessorFilter  *stylist.ProcessorFilter
+	ApplySuggestions bool
+	Interactive      bool
 
 	pathSpecs []string
 }
@@ -64,19 +80,150 @@ func (a *FixAction) Run(ctx context.Context) error {
 	pipeline := stylist.NewPipeline(processors, excludes)
 
 	cwd, _ := os.Getwd()
+
+	var reviewer *stylist.FixReviewer
+	var matched stylist.PathSet
+	if a.Interactive {
+		// Snapshot everything the processors might change
+		// so that the changes can be reviewed afterwards.
+		reviewer = stylist.NewFixReviewer(a.IO, a.Prompter, a.Config)
+		defer reviewer.Close()
+		matched, err = a.snapshot(ctx, pipeline, reviewer, cwd)
+		if err != nil {
+			return err
+		}
+	}
+
 	results, err := pipeline.Fix(ctx, cwd, a.pathSpecs)
 	if err != nil {
 		return err
 	}
+	run := pipeline.RunInfo()
+	run.StartedAt = a.StartedAt
+
+	var fixed []*stylist.Result
+	if a.ApplySuggestions {
+		// Run after the fix commands so the suggestions
+		// are based on the current file contents.
+		fixed, err = pipeline.ApplySuggestions(ctx, cwd, a.pathSpecs)
+		if err != nil {
+			return err
+		}
+	}
+
+	if reviewer != nil {
+		results, fixed, err = a.review(ctx, pipeline, reviewer, cwd, matched, results, fixed)
+		if err != nil {
+			return err
+		}
+	}
+
+	if a.ApplySuggestions {
+		a.Logger.Infof("Applied suggested fixes for %d issue(s)", len(fixed))
+	}
 
 	for _, result := range results {
 		a.Logger.Debug(fmt.Sprintf("%#v", result))
 	}
 
-	err = stylist.NewResultPrinter(a.IO, a.Config).Print(results)
+	err = stylist.PrintResults(a.IO, a.Config, results, run)
 	if err != nil {
 		return err
 	}
 
-	return stylist.NewResultsError(results)
+	return stylist.NewThresholdResultsError(
+		results, a.Config.Output.FailLevel, a.Config.Output.MaxWarnings,
+	)
+}
+
+// Snapshots the paths that the processors are able to change.
+// Returns all the matched paths.
+func (a *FixAction) snapshot(
+	ctx context.Context, pipeline *stylist.Pipeline, reviewer *stylist.FixReviewer, cwd string,
+) (stylist.PathSet, error) {
+	matches, err := pipeline.Match(ctx, cwd, a.pathSpecs)
+	if err != nil {
+		return nil, err
+	}
+	matched := stylist.NewPathSet()
+	for _, match := range matches {
+		matched.Append(match.Paths...)
+		canChange := match.Processor.FixCommand != nil ||
+			(a.ApplySuggestions && match.Processor.CheckCommand != nil)
+		if !canChange {
+			continue
+		}
+		if err := reviewer.Snapshot(match.Paths...); err != nil {
+			return nil, err
+		}
+	}
+	return matched, nil
+}
+
+// Reviews the changes made by the fixers. Since skipped changes are reverted,
+// any results for the reverted paths are replaced by re-checking them.
+func (a *FixAction) review(
+	ctx context.Context,
+	pipeline *stylist.Pipeline,
+	reviewer *stylist.FixReviewer,
+	cwd string,
+	matched stylist.PathSet,
+	results []*stylist.Result,
+	fixed []*stylist.Result,
+) ([]*stylist.Result, []*stylist.Result, error) {
+	// Anything matching now that didn't before was created by a fixer.
+	matches, err := pipeline.Match(ctx, cwd, a.pathSpecs)
+	if err != nil {
+		return nil, nil, err
+	}
+	created := []string{}
+	for _, match := range matches {
+		for _, path := range match.Paths {
+			if !matched.Contains(path) {
+				created = append(created, path)
+			}
+		}
+	}
+
+	reverted, err := reviewer.Review(created...)
+	if err != nil || len(reverted) == 0 {
+		return results, fixed, err
+	}
+
+	// Results are reported using display paths.
+	adjuster := stylist.NewPathAdjuster(cwd, a.Config.Output.Paths)
+	stale := stylist.NewPathSet()
+	recheck := []string{}
+	for _, path := range reverted {
+		displayPath, err := adjuster.Convert(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		stale.Add(displayPath)
+		if _, err := os.Stat(path); err == nil {
+			recheck = append(recheck, path) // i.e. not a removed new file
+		}
+	}
+	current := func(results []*stylist.Result) []*stylist.Result {
+		kept := []*stylist.Result{}
+		for _, result := range results {
+			if !stale.Contains(result.Location.Path) {
+				kept = append(kept, result)
+			}
+		}
+		return kept
+	}
+
+	checked := []*stylist.Result{}
+	if len(recheck) > 0 {
+		checked, err = pipeline.Check(ctx, cwd, recheck)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	results, err = stylist.SortResults(ctx, append(current(results), checked...))
+	if err != nil {
+		return nil, nil, err
+	}
+	return results, current(fixed), nil
 }
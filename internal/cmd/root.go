@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -9,6 +10,25 @@ import (
 	"github.com/twelvelabs/stylist/internal/stylist"
 )
 
+const (
+	// ExitCodeIssues is used when the processors found issues.
+	ExitCodeIssues = 1
+	// ExitCodeFailure is used when stylist (or a processor) failed to run.
+	ExitCodeFailure = 2
+)
+
+// ExitCode returns the process exit code for the given error.
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var resultsErr *stylist.ResultsError
+	if errors.As(err, &resultsErr) {
+		return ExitCodeIssues
+	}
+	return ExitCodeFailure
+}
+
 func NewRootCmd(app *stylist.App) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:          "stylist",
@@ -36,6 +56,7 @@ func NewRootCmd(app *stylist.App) *cobra.Command {
 
 	cmd.AddCommand(NewCheckCmd(app))
 	cmd.AddCommand(NewFixCmd(app))
+	cmd.AddCommand(NewExplainCmd(app))
 	cmd.AddCommand(NewFilesCmd(app))
 	cmd.AddCommand(NewInitCmd(app))
 	cmd.AddCommand(NewVersionCmd(app))
@@ -29,6 +29,19 @@ func addOutputFlags(cmd *cobra.Command, oc *stylist.OutputConfig) {
 		panic(err)
 	}
 
+	cmd.Flags().StringVar(
+		&oc.Template,
+		"template",
+		oc.Template,
+		"Go `TEMPLATE` (or path to a template file) used when --format=template",
+	)
+
+	outputHelp := "Write results as `FORMAT=PATH` (repeatable, omit PATH for stdout)"
+	cmd.Flags().VarP(&outputTargetsValue{targets: &oc.Targets}, "output", "o", outputHelp)
+	if err := cmd.RegisterFlagCompletionFunc("output", formatCompFunc); err != nil {
+		panic(err)
+	}
+
 	sortNames := stylist.ResultSortNames()
 	sortHelp := fmt.Sprintf(
 		"Sort issues by [`SORT`: %s]",
@@ -45,6 +58,22 @@ func addOutputFlags(cmd *cobra.Command, oc *stylist.OutputConfig) {
 		panic(err)
 	}
 
+	groupNames := stylist.ResultGroupNames()
+	groupHelp := fmt.Sprintf(
+		"Group TTY output by [`GROUP`: %s]",
+		strings.Join(groupNames, ", "),
+	)
+	groupCompFunc := func(cmd *cobra.Command, args []string, toComplete string) (
+		[]string, cobra.ShellCompDirective,
+	) {
+		return groupNames, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	cmd.Flags().Var(&oc.GroupBy, "group-by", groupHelp)
+	if err := cmd.RegisterFlagCompletionFunc("group-by", groupCompFunc); err != nil {
+		panic(err)
+	}
+
 	severityNames := stylist.ResultLevelNames()
 	severityHelp := "Comma separated list of severities to display"
 	severityCompFunc := func(cmd *cobra.Command, args []string, toComplete string) (
@@ -58,6 +87,22 @@ func addOutputFlags(cmd *cobra.Command, oc *stylist.OutputConfig) {
 		panic(err)
 	}
 
+	failLevelHelp := fmt.Sprintf(
+		"Minimum severity that causes a non-zero exit [`LEVEL`: %s]",
+		strings.Join(severityNames, ", "),
+	)
+	cmd.Flags().Var(&oc.FailLevel, "fail-level", failLevelHelp)
+	if err := cmd.RegisterFlagCompletionFunc("fail-level", severityCompFunc); err != nil {
+		panic(err)
+	}
+
+	cmd.Flags().IntVar(
+		&oc.MaxWarnings,
+		"max-warnings",
+		oc.MaxWarnings,
+		"Number of warnings that causes a non-zero exit (-1 for no limit)",
+	)
+
 	boolCompFunc := func(cmd *cobra.Command, args []string, toComplete string) (
 		[]string, cobra.ShellCompDirective,
 	) {
@@ -71,6 +116,13 @@ func addOutputFlags(cmd *cobra.Command, oc *stylist.OutputConfig) {
 		panic(err)
 	}
 
+	cmd.Flags().IntVarP(
+		&oc.ContextBefore, "context-before", "B", oc.ContextBefore, "Number of context lines to show before each issue",
+	)
+	cmd.Flags().IntVarP(
+		&oc.ContextAfter, "context-after", "A", oc.ContextAfter, "Number of context lines to show after each issue",
+	)
+
 	cmd.Flags().BoolVar(
 		&oc.ShowURL, "show-url", oc.ShowURL, "Show issue URLs when available",
 	)
@@ -78,12 +130,61 @@ func addOutputFlags(cmd *cobra.Command, oc *stylist.OutputConfig) {
 		panic(err)
 	}
 
+	cmd.Flags().BoolVar(
+		&oc.ShowSummary, "summary", oc.ShowSummary, "Show a summary after the TTY output",
+	)
+	if err := cmd.RegisterFlagCompletionFunc("summary", boolCompFunc); err != nil {
+		panic(err)
+	}
+
 	cmd.Flags().BoolVar(
 		&oc.SyntaxHighlight, "highlight", oc.SyntaxHighlight, "Syntax highlight context lines",
 	)
 	if err := cmd.RegisterFlagCompletionFunc("highlight", boolCompFunc); err != nil {
 		panic(err)
 	}
+
+	cmd.Flags().BoolVar(
+		&oc.ReportUnusedSuppressions,
+		"report-unused-suppressions",
+		oc.ReportUnusedSuppressions,
+		"Report stylist:ignore comments that did not match any issues (check only)",
+	)
+	if err := cmd.RegisterFlagCompletionFunc("report-unused-suppressions", boolCompFunc); err != nil {
+		panic(err)
+	}
+}
+
+// outputTargetsValue is a repeatable flag.Value for output targets.
+// The first use of the flag replaces any targets from the config file.
+type outputTargetsValue struct {
+	targets *[]*stylist.OutputTarget
+	changed bool
+}
+
+func (v *outputTargetsValue) Set(value string) error {
+	target, err := stylist.ParseOutputTarget(value)
+	if err != nil {
+		return err
+	}
+	if !v.changed {
+		*v.targets = nil
+		v.changed = true
+	}
+	*v.targets = append(*v.targets, target)
+	return nil
+}
+
+func (v *outputTargetsValue) String() string {
+	values := []string{}
+	for _, target := range *v.targets {
+		values = append(values, target.String())
+	}
+	return strings.Join(values, ",")
+}
+
+func (v *outputTargetsValue) Type() string {
+	return "stringArray"
 }
 
 func addProcessorFilterFlags(cmd *cobra.Command, filter *stylist.ProcessorFilter) {
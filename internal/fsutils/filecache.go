@@ -3,6 +3,7 @@ package fsutils
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"sync"
 )
 
@@ -12,13 +13,15 @@ func NewFileCache() *FileCache {
 }
 
 // FileCache is a utility for caching file contents.
+// Relative and absolute paths to the same file share a cache entry.
 type FileCache struct {
 	files sync.Map
 }
 
 // GetFileBytes returns the cached bytes for path.
 func (fc *FileCache) GetFileBytes(path string) ([]byte, bool, error) {
-	cachedBytes, ok := fc.files.Load(path)
+	key := fileCacheKey(path)
+	cachedBytes, ok := fc.files.Load(key)
 	if ok {
 		return cachedBytes.([]byte), true, nil
 	}
@@ -28,6 +31,19 @@ func (fc *FileCache) GetFileBytes(path string) ([]byte, bool, error) {
 		return nil, false, fmt.Errorf("file cache: %w", err)
 	}
 
-	fc.files.Store(path, fileBytes)
+	fc.files.Store(key, fileBytes)
 	return fileBytes, false, nil
 }
+
+// SetFileBytes caches data for path in place of the file contents
+// (i.e. for unsaved content that hasn't been written to disk).
+func (fc *FileCache) SetFileBytes(path string, data []byte) {
+	fc.files.Store(fileCacheKey(path), data)
+}
+
+func fileCacheKey(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+	return path
+}
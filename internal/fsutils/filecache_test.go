@@ -1,6 +1,7 @@
 package fsutils
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -26,3 +27,27 @@ func TestFileCache_GetFileBytes(t *testing.T) {
 	assert.Equal(t, true, ok)
 	assert.NoError(t, err)
 }
+
+func TestFileCache_SetFileBytes(t *testing.T) {
+	cache := NewFileCache()
+	cache.SetFileBytes("testdata/example.txt", []byte("unsaved\n"))
+
+	data, ok, err := cache.GetFileBytes("testdata/example.txt")
+	assert.Equal(t, "unsaved\n", string(data))
+	assert.Equal(t, true, ok)
+	assert.NoError(t, err)
+
+	// Absolute paths share the cache entry.
+	abs, _ := filepath.Abs("testdata/example.txt")
+	data, ok, err = cache.GetFileBytes(abs)
+	assert.Equal(t, "unsaved\n", string(data))
+	assert.Equal(t, true, ok)
+	assert.NoError(t, err)
+
+	// Files need not exist.
+	cache.SetFileBytes("does/not/exist.txt", []byte("new\n"))
+	data, ok, err = cache.GetFileBytes("does/not/exist.txt")
+	assert.Equal(t, "new\n", string(data))
+	assert.Equal(t, true, ok)
+	assert.NoError(t, err)
+}
@@ -34,6 +34,20 @@ func (lc *LineCache) GetLine(path string, index1 int) (string, error) {
 	return string(bytes.Trim(line, "\r")), nil
 }
 
+// GetLines returns all the lines from the file at path.
+func (lc *LineCache) GetLines(path string) ([]string, error) {
+	rawLines, _, err := lc.getRawLines(path)
+	if err != nil {
+		return nil, fmt.Errorf("line cache: %w", err)
+	}
+
+	lines := make([]string, 0, len(rawLines))
+	for _, line := range rawLines {
+		lines = append(lines, string(bytes.Trim(line, "\r")))
+	}
+	return lines, nil
+}
+
 func (lc *LineCache) getRawLine(path string, index0 int) ([]byte, error) {
 	lines, _, err := lc.getRawLines(path)
 	if err != nil {
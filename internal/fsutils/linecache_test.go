@@ -63,6 +63,18 @@ func TestLineCache_GetLine(t *testing.T) {
 	}
 }
 
+func TestLineCache_GetLines(t *testing.T) {
+	cache := NewLineCache(NewFileCache())
+
+	lines, err := cache.GetLines("testdata/example.txt")
+	assert.Equal(t, []string{"one", "two", "three", ""}, lines)
+	assert.NoError(t, err)
+
+	lines, err = cache.GetLines("testdata/does-not-exist.txt")
+	assert.Nil(t, lines)
+	assert.ErrorContains(t, err, "no such file or directory")
+}
+
 func TestLineCache_getRawLinesIsCached(t *testing.T) {
 	cache := NewLineCache(NewFileCache())
 	expected := [][]byte{
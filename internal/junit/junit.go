@@ -0,0 +1,45 @@
+package junit
+
+import (
+	"encoding/xml"
+)
+
+// JUTestSuites represents a JUnit XML report.
+//
+//	<?xml version="1.0" encoding="UTF-8"?>
+//	<testsuites tests="2" failures="1">
+//		<testsuite name="suite" tests="2" failures="1">
+//			<testcase name="case1" classname="suite"></testcase>
+//			<testcase name="case2" classname="suite">
+//				<failure message="msg" type="error">details</failure>
+//			</testcase>
+//		</testsuite>
+//	</testsuites>
+type JUTestSuites struct {
+	XMLName  xml.Name       `xml:"testsuites"`
+	Tests    int            `xml:"tests,attr"`
+	Failures int            `xml:"failures,attr"`
+	Suites   []*JUTestSuite `xml:"testsuite,omitempty"`
+}
+
+// JUTestSuite represents a JUnit XML testsuite element.
+type JUTestSuite struct {
+	Name      string        `xml:"name,attr"`
+	Tests     int           `xml:"tests,attr"`
+	Failures  int           `xml:"failures,attr"`
+	TestCases []*JUTestCase `xml:"testcase"`
+}
+
+// JUTestCase represents a JUnit XML testcase element.
+type JUTestCase struct {
+	Name      string     `xml:"name,attr"`
+	ClassName string     `xml:"classname,attr"`
+	Failure   *JUFailure `xml:"failure,omitempty"`
+}
+
+// JUFailure represents a JUnit XML failure element.
+type JUFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr,omitempty"`
+	Content string `xml:",chardata"`
+}
@@ -0,0 +1,77 @@
+package rdjson
+
+// Severity values used by the reviewdog diagnostic format.
+const (
+	SeverityUnknown = "UNKNOWN_SEVERITY"
+	SeverityError   = "ERROR"
+	SeverityWarning = "WARNING"
+	SeverityInfo    = "INFO"
+)
+
+// RDDiagnosticResult represents a reviewdog rdjson document.
+//
+//	{
+//		"source": {"name": "linter", "url": "https://example.com"},
+//		"severity": "WARNING",
+//		"diagnostics": [
+//			{
+//				"message": "msg",
+//				"location": {"path": "file.go", "range": {"start": {"line": 1, "column": 3}}},
+//				"code": {"value": "rule-id", "url": "https://example.com/rule-id"}
+//			}
+//		]
+//	}
+//
+// The rdjsonl variant is a stream of newline delimited RDDiagnostic objects.
+type RDDiagnosticResult struct {
+	Source      *RDSource       `json:"source,omitempty"`
+	Severity    string          `json:"severity,omitempty"`
+	Diagnostics []*RDDiagnostic `json:"diagnostics"`
+}
+
+// RDDiagnostic represents a single reviewdog diagnostic.
+type RDDiagnostic struct {
+	Message     string          `json:"message"`
+	Location    *RDLocation     `json:"location,omitempty"`
+	Severity    string          `json:"severity,omitempty"`
+	Source      *RDSource       `json:"source,omitempty"`
+	Code        *RDCode         `json:"code,omitempty"`
+	Suggestions []*RDSuggestion `json:"suggestions,omitempty"`
+}
+
+// RDSource represents the tool that produced a diagnostic.
+type RDSource struct {
+	Name string `json:"name"`
+	URL  string `json:"url,omitempty"`
+}
+
+// RDCode represents the rule that produced a diagnostic.
+type RDCode struct {
+	Value string `json:"value"`
+	URL   string `json:"url,omitempty"`
+}
+
+// RDLocation represents the location of a diagnostic.
+type RDLocation struct {
+	Path  string   `json:"path"`
+	Range *RDRange `json:"range,omitempty"`
+}
+
+// RDRange represents a range within a file.
+// Lines and columns are 1-based, and the end position is exclusive.
+type RDRange struct {
+	Start *RDPosition `json:"start,omitempty"`
+	End   *RDPosition `json:"end,omitempty"`
+}
+
+// RDPosition represents a position within a file.
+type RDPosition struct {
+	Line   int `json:"line,omitempty"`
+	Column int `json:"column,omitempty"`
+}
+
+// RDSuggestion represents a suggested replacement for a range.
+type RDSuggestion struct {
+	Range *RDRange `json:"range"`
+	Text  string   `json:"text"`
+}
@@ -16,9 +16,10 @@ import (
 type ctxKey string
 
 const (
-	ctxCmdClient ctxKey = "stylist.CmdClient"
-	ctxConfig    ctxKey = "stylist.Config"
-	ctxLogger    ctxKey = "stylist.Logger"
+	ctxCmdClient   ctxKey = "stylist.CmdClient"
+	ctxConfig      ctxKey = "stylist.Config"
+	ctxLogger      ctxKey = "stylist.Logger"
+	ctxStdinBuffer ctxKey = "stylist.StdinBuffer"
 )
 
 type App struct {
@@ -29,6 +30,7 @@ type App struct {
 	Prompter  ui.Prompter
 	CmdClient *run.Client
 	Logger    *logrus.Logger
+	StartedAt time.Time
 }
 
 // InitContext returns a new context set with app values.
@@ -75,6 +77,7 @@ func NewApp(meta *AppMeta) (*App, error) {
 		Prompter:  ui.NewSurveyPrompter(ios),
 		CmdClient: run.NewClient(),
 		Logger:    logger,
+		StartedAt: startedAt,
 	}
 
 	logger.Debugf("Initialized app in %s", time.Since(startedAt))
@@ -97,6 +100,7 @@ func NewTestApp() *App {
 		Prompter:  ui.NewStubPrompter(ios),
 		CmdClient: run.NewClient().WithStubbing(),
 		Logger:    logger,
+		StartedAt: time.Now(),
 	}
 
 	return app
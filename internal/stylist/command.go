@@ -10,10 +10,14 @@ import (
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/shlex"
+	"github.com/twelvelabs/termite/render"
+	"github.com/twelvelabs/termite/run"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -23,14 +27,34 @@ var (
 
 // Command represents a check or fix command to be run by a Processor.
 type Command struct {
-	Template      string        `yaml:"command,omitempty"`
-	InputType     InputType     `yaml:"input,omitempty"    default:"variadic"`
-	OutputType    OutputType    `yaml:"output,omitempty"   default:"stdout"`
-	OutputFormat  OutputFormat  `yaml:"format,omitempty"   default:"none"`
-	ResultMapping ResultMapping `yaml:"mapping,omitempty"`
-	Parallelism   int           `yaml:"parallelism,omitempty"`
-	BatchSize     int           `yaml:"batch_size,omitempty"`
-	WorkingDir    string        `yaml:"working_dir,omitempty"`
+	Template     string       `yaml:"command,omitempty"`
+	InputType    InputType    `yaml:"input,omitempty"    default:"variadic"`
+	OutputType   OutputType   `yaml:"output,omitempty"   default:"stdout"`
+	OutputFormat OutputFormat `yaml:"format,omitempty"   default:"none"`
+	// OutputPath is the path of the report file when OutputType is file.
+	// It may reference {{ .TempDir }}, and the command may reference
+	// {{ .TempDir }} and {{ .OutputPath }}.
+	OutputPath *render.Template `yaml:"output_path,omitempty"`
+	// StdinFilename is rendered and appended to the command whenever file
+	// contents are passed via stdin (i.e. "--stdin-filename={{ .Path }}").
+	// Arg and variadic commands without it check a temp copy of a stdin buffer.
+	StdinFilename *render.Template `yaml:"stdin_filename,omitempty"`
+	// ResultMappings may be a single mapping or a list of them.
+	// Each mapping is tried in order (see ParseMappings).
+	ResultMappings ResultMappings `yaml:"mapping,omitempty"`
+	Parallelism    int            `yaml:"parallelism,omitempty"`
+	BatchSize      int            `yaml:"batch_size,omitempty"`
+	WorkingDir     string         `yaml:"working_dir,omitempty"`
+}
+
+// Returns a copy of the command that shares no mutable state with it.
+func (c *Command) clone() *Command {
+	if c == nil {
+		return nil
+	}
+	dst := *c
+	dst.ResultMappings = slices.Clone(c.ResultMappings)
+	return &dst
 }
 
 // Execute executes paths concurrently in batches on behalf of the named processor.
@@ -42,7 +66,7 @@ func (c *Command) Execute(
 	group, ctx := errgroup.WithContext(ctx)
 	group.SetLimit(c.parallelism())
 
-	for _, batch := range c.partition(paths) {
+	for _, batch := range c.partitionStdinBuffer(ctx, basePath, paths) {
 		group.Go(func() error {
 			batchResults, err := c.executeBatch(ctx, name, basePath, batch)
 			if err != nil {
@@ -69,8 +93,23 @@ func (c *Command) executeBatch(
 	logger := AppLogger(ctx)
 	client := AppCmdClient(ctx)
 
-	// TODO: render template string
-	args, err := shlex.Split(c.Template)
+	// Commands that write a report file get a temp dir to write it to.
+	template := c.Template
+	outputPath := ""
+	if c.OutputType == OutputTypeFile {
+		tempDir, err := os.MkdirTemp("", "stylist-")
+		if err != nil {
+			return nil, err
+		}
+		defer os.RemoveAll(tempDir)
+
+		template, outputPath, err = c.renderFileTemplates(tempDir)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	args, err := shlex.Split(template)
 	if err != nil {
 		return nil, err
 	}
@@ -78,64 +117,132 @@ func (c *Command) executeBatch(
 		return nil, ErrCommandEmpty
 	}
 
-	if c.InputType == InputTypeArg {
-		args = append(args, paths[0])
-	}
-	if c.InputType == InputTypeVariadic {
-		args = append(args, paths...)
+	buf := c.stdinBufferFor(ctx, basePath, paths)
+	stdinPath, stdin, err := c.openStdin(buf, paths)
+	if err != nil {
+		return nil, err
 	}
+	// The path of the temp file holding buf when it can't be passed via stdin.
+	bufPath := ""
+	if stdin != nil {
+		defer stdin.Close()
 
-	cmd := client.CommandContext(ctx, args[0], args[1:]...)
-	cmd.Dir = filepath.Join(basePath, c.WorkingDir)
-
-	// Setup the IO streams
-	if c.InputType == InputTypeStdin {
-		file, err := os.Open(paths[0])
+		stdinArgs, err := c.renderStdinFilename(stdinPath)
 		if err != nil {
 			return nil, err
 		}
-		cmd.Stdin = file
-	}
-	stderr := &bytes.Buffer{}
-	stdout := &bytes.Buffer{}
-	combined := &bytes.Buffer{}
-	cmd.Stderr = io.MultiWriter(stderr, combined)
-	cmd.Stdout = io.MultiWriter(stdout, combined)
-
-	logger.Debugln("Command:", cmd.String())
+		args = append(args, stdinArgs...)
+	} else {
+		inputPaths := paths
+		if buf != nil {
+			tempDir, err := os.MkdirTemp("", "stylist-stdin-")
+			if err != nil {
+				return nil, err
+			}
+			defer os.RemoveAll(tempDir)
 
-	startedAt := time.Now()
-	err = cmd.Run()
-	duration := time.Since(startedAt)
+			bufPath, err = c.writeStdinBuffer(tempDir, buf)
+			if err != nil {
+				return nil, err
+			}
+			inputPaths = []string{bufPath}
+		}
+		if c.InputType == InputTypeArg {
+			args = append(args, inputPaths[0])
+		}
+		if c.InputType == InputTypeVariadic {
+			args = append(args, inputPaths...)
+		}
+	}
 
-	// Ignoring ExitError so we can parse the output.
-	var exitErr *exec.ExitError
-	if err != nil && !errors.As(err, &exitErr) {
-		// non-ExitError (binary not found, permissions error, etc).
-		return nil, err
+	cmd := client.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Dir = filepath.Join(basePath, c.WorkingDir)
+	if outputPath != "" && !filepath.IsAbs(outputPath) {
+		outputPath = filepath.Join(cmd.Dir, outputPath)
 	}
 
-	// Build a CommandOutput struct...
-	content := stdout
-	if c.OutputType == OutputTypeStderr {
-		content = stderr
+	// Setup the IO streams.
+	if stdin != nil {
+		cmd.Stdin = stdin
 	}
+	// Only the tail of the combined output is kept in memory
+	// (for logging and the unparsable output fallback below).
+	combined := newTailBuffer(maxRetainedOutputBytes)
+
+	logger.Debugln("Command:", cmd.String())
+
 	output := CommandOutput{
 		Processor: name,
 		Command:   cmd.String(),
-		Content:   content,
-		Duration:  duration,
-		ExitCode:  cmd.ExitCode(),
 	}
 
+	// Do a little post processing on the results as they are parsed.
+	pathSet := NewNormalizedPathSet(basePath, paths...)
+	resolvePath := func(path string) string {
+		if stdinPath != "" && isStdinPlaceholder(path) {
+			// There's only one input path, so it must be this one.
+			return stdinPath
+		}
+		path = c.cleanupPath(basePath, path)
+		if bufPath != "" && path == bufPath {
+			return paths[0]
+		}
+		return path
+	}
+	parsed := 0
+	transformed := []*Result{}
+	collect := func(r *Result) error {
+		logger.Debugf("Parsed[%v]: %#v", parsed, r)
+		parsed++
+		// Ensure the path is correct and normalized so we can match it below.
+		r.Location.Path = resolvePath(r.Location.Path)
+		for _, fix := range r.Fixes {
+			if fix.Location.Path == "" {
+				fix.Location.Path = r.Location.Path
+			} else {
+				fix.Location.Path = resolvePath(fix.Location.Path)
+			}
+		}
+		for _, loc := range r.SecondaryLocations() {
+			loc.Path = resolvePath(loc.Path)
+		}
+		// Add the processor name to the results
+		r.Source = name
+		// InputTypeNone doesn't pass `paths` to the command, so there may
+		// be results for paths we don't care about. Filter those out.
+		if pathSet.Contains(r.Location.Path) || r.Location.Path == "" {
+			transformed = append(transformed, r)
+		}
+		return nil
+	}
+
+	// Run the command and parse the output using the appropriate parser.
+	parser := NewOutputParser(c.OutputFormat)
+	startedAt := time.Now()
+	if c.OutputType == OutputTypeFile {
+		cmd.Stdout = combined
+		cmd.Stderr = combined
+		err = commandRunError(cmd.Run())
+		output.Duration = time.Since(startedAt)
+		if err == nil {
+			err = c.parseOutputFile(outputPath, output, parser, collect)
+		}
+	} else {
+		err = c.runAndStream(cmd, combined, output, parser, collect)
+		output.Duration = time.Since(startedAt)
+	}
+	output.ExitCode = cmd.ExitCode()
+
+	// The content has already been consumed by the parser,
+	// so log the retained tail of the combined output instead.
+	output.Content = strings.NewReader(combined.String())
 	logger.Debugln("Output:", output.String())
 
-	// Parse the output using the appropriate parser.
-	parsed, err := NewOutputParser(c.OutputFormat).Parse(output, c.ResultMapping)
 	if err != nil {
 		return nil, err
 	}
-	if len(parsed) == 0 && output.ExitCode > 0 {
+
+	if parsed == 0 && output.ExitCode > 0 {
 		// The command didn't exit successfully, but we were unable
 		// to parse anything.
 		// We don't know which path triggered the issue,
@@ -155,27 +262,217 @@ func (c *Command) executeBatch(
 				ContextLang:  "plaintext",
 				ContextLines: contextLines,
 			}
-			parsed = append(parsed, result)
+			_ = collect(result)
 		}
 	}
 
-	// Do a little post processing on the results.
-	pathSet := NewNormalizedPathSet(basePath, paths...)
-	transformed := []*Result{}
-	for idx, r := range parsed {
-		logger.Debugf("Parsed[%v]: %#v", idx, r)
-		// Ensure the path is correct and normalized so we can match it below.
-		r.Location.Path = c.cleanupPath(basePath, r.Location.Path)
-		// Add the processor name to the results
-		r.Source = name
-		// InputTypeNone doesn't pass `paths` to the command, so there may
-		// be results for paths we don't care about. Filter those out.
-		if pathSet.Contains(r.Location.Path) || r.Location.Path == "" {
-			transformed = append(transformed, r)
+	return transformed, nil
+}
+
+// Returns the stdin buffer when it is for the only path in paths, or nil.
+func (c *Command) stdinBufferFor(ctx context.Context, basePath string, paths []string) *StdinBuffer {
+	buf := StdinBufferFrom(ctx)
+	if buf == nil || len(paths) != 1 || c.InputType == InputTypeNone {
+		return nil
+	}
+	if NormalizePath(basePath, buf.Path) != NormalizePath(basePath, paths[0]) {
+		return nil
+	}
+	return buf
+}
+
+// Returns the path whose contents should be passed via stdin and
+// a reader for those contents, or a nil reader when the command doesn't
+// read from stdin. The stdin buffer (if any) takes precedence over
+// the file on disk.
+func (c *Command) openStdin(buf *StdinBuffer, paths []string) (string, io.ReadCloser, error) {
+	if buf != nil && c.acceptsStdinBuffer() {
+		return paths[0], io.NopCloser(bytes.NewReader(buf.Content)), nil
+	}
+	if c.InputType != InputTypeStdin {
+		return "", nil, nil
+	}
+	file, err := os.Open(paths[0])
+	if err != nil {
+		return "", nil, err
+	}
+	return paths[0], file, nil
+}
+
+// Returns true if the command can read a stdin buffer via stdin.
+// Otherwise the buffer is written to a temp file (see writeStdinBuffer).
+func (c *Command) acceptsStdinBuffer() bool {
+	switch c.InputType {
+	case InputTypeStdin:
+		return true
+	case InputTypeArg, InputTypeVariadic:
+		return c.StdinFilename != nil
+	default:
+		return false
+	}
+}
+
+// Writes the contents of buf to a file in dir for commands that can only
+// read from disk. The file keeps the base name of buf.Path so that tools
+// detecting the file type by name still recognize it.
+func (c *Command) writeStdinBuffer(dir string, buf *StdinBuffer) (string, error) {
+	path := filepath.Join(dir, filepath.Base(buf.Path))
+	if err := os.WriteFile(path, buf.Content, 0o600); err != nil {
+		return "", fmt.Errorf("unable to write stdin buffer: %w", err)
+	}
+	return path, nil
+}
+
+// Moves the stdin buffer path (if any) into its own batch
+// so that its contents can be checked separately.
+func (c *Command) partitionStdinBuffer(ctx context.Context, basePath string, paths []string) [][]string {
+	buf := StdinBufferFrom(ctx)
+	if buf == nil || c.InputType != InputTypeVariadic {
+		return c.partition(paths)
+	}
+
+	bufPath := NormalizePath(basePath, buf.Path)
+	matched := ""
+	others := []string{}
+	for _, path := range paths {
+		if NormalizePath(basePath, path) == bufPath {
+			matched = path
+		} else {
+			others = append(others, path)
 		}
 	}
+	if matched == "" {
+		return c.partition(paths)
+	}
+	return append(c.partition(others), []string{matched})
+}
 
-	return transformed, nil
+// Returns true if path is how tools commonly refer to stdin.
+func isStdinPlaceholder(path string) bool {
+	switch path {
+	case "-", "<stdin>", "stdin", "/dev/stdin", "<text>":
+		return true
+	default:
+		return false
+	}
+}
+
+// Renders the stdin filename args for path.
+func (c *Command) renderStdinFilename(path string) ([]string, error) {
+	if c.StdinFilename == nil {
+		return nil, nil
+	}
+	rendered, err := c.StdinFilename.Render(&commandTemplateData{
+		Path: path,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid stdin_filename: %w", err)
+	}
+	return shlex.Split(rendered)
+}
+
+// Renders the command and output path templates for OutputTypeFile.
+func (c *Command) renderFileTemplates(tempDir string) (string, string, error) {
+	if c.OutputPath == nil {
+		return "", "", fmt.Errorf("output_path is required when output type is file")
+	}
+	data := &commandTemplateData{
+		TempDir: tempDir,
+	}
+	outputPath, err := c.OutputPath.Render(data)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid output_path: %w", err)
+	}
+	data.OutputPath = strings.TrimSpace(outputPath)
+	if data.OutputPath == "" {
+		return "", "", fmt.Errorf("output_path rendered to an empty path")
+	}
+
+	template, err := render.String(c.Template, data)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid command: %w", err)
+	}
+	return template, data.OutputPath, nil
+}
+
+// Streams the output content to the parser while the command runs.
+// Errors running the command take precedence over parser errors
+// (which are likely caused by the command not running).
+func (c *Command) runAndStream(
+	cmd *run.Cmd, combined io.Writer, output CommandOutput, parser OutputParser, emit ResultEmitter,
+) error {
+	reader, writer := io.Pipe()
+	if c.OutputType == OutputTypeStderr {
+		cmd.Stderr = io.MultiWriter(writer, combined)
+		cmd.Stdout = combined
+	} else {
+		cmd.Stdout = io.MultiWriter(writer, combined)
+		cmd.Stderr = combined
+	}
+
+	runErrs := make(chan error, 1)
+	go func() {
+		err := cmd.Run()
+		_ = writer.Close()
+		runErrs <- err
+	}()
+
+	output.Content = reader
+	parseErr := ParseMappings(parser, output, c.ResultMappings, emit)
+	if parseErr != nil {
+		// Unblock the command so it can exit.
+		_ = reader.CloseWithError(parseErr)
+	} else {
+		// Drain anything the parser didn't need.
+		_, _ = io.Copy(io.Discard, reader)
+	}
+	runErr := commandRunError(<-runErrs)
+	if runErr != nil && !errors.Is(runErr, parseErr) {
+		// i.e. not just failing to write to the pipe closed above.
+		return runErr
+	}
+	return parseErr
+}
+
+// Returns err unless it's an ExitError, which is ignored
+// so that the output of failing commands can be parsed.
+func commandRunError(err error) error {
+	var exitErr *exec.ExitError
+	if err != nil && !errors.As(err, &exitErr) {
+		// non-ExitError (binary not found, permissions error, etc).
+		return err
+	}
+	return nil
+}
+
+// Parses the report file written by the command.
+// A missing file is treated as empty output, since some tools
+// don't write a report when there is nothing to report.
+func (c *Command) parseOutputFile(
+	path string, output CommandOutput, parser OutputParser, emit ResultEmitter,
+) error {
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	output.Content = file
+	return ParseMappings(parser, output, c.ResultMappings, emit)
+}
+
+// commandTemplateData is the data used to render
+// the command, output path, and stdin filename templates.
+type commandTemplateData struct {
+	// Path is the path of the file whose contents are passed via stdin.
+	Path string
+	// TempDir is a temporary directory that is removed after the command runs.
+	TempDir string
+	// OutputPath is the rendered output path (empty when rendering OutputPath itself).
+	OutputPath string
 }
 
 func (c *Command) cleanupPath(basePath, path string) string {
@@ -234,6 +531,40 @@ func (c *Command) partition(paths []string) [][]string {
 	return batches
 }
 
+// maxRetainedOutputBytes is the amount of combined command output
+// kept in memory after it has been streamed to the output parser.
+const maxRetainedOutputBytes = 64 * 1024
+
+// tailBuffer is a concurrency safe writer that only retains
+// the last limit bytes written to it.
+type tailBuffer struct {
+	mu    sync.Mutex
+	limit int
+	buf   []byte
+}
+
+func newTailBuffer(limit int) *tailBuffer {
+	return &tailBuffer{limit: limit}
+}
+
+func (b *tailBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.buf = append(b.buf, p...)
+	if len(b.buf) > b.limit {
+		b.buf = b.buf[len(b.buf)-b.limit:]
+	}
+	return len(p), nil
+}
+
+func (b *tailBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return string(b.buf)
+}
+
 // CommandOutput contains the result of a single command invocation.
 type CommandOutput struct {
 	Processor string
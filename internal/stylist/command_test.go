@@ -2,10 +2,15 @@ package stylist
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"os"
 	"runtime"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/twelvelabs/termite/render"
 	"github.com/twelvelabs/termite/run"
 )
 
@@ -14,6 +19,7 @@ func TestCommand_Execute(t *testing.T) {
 		desc     string
 		command  *Command
 		paths    []string
+		stdin    *StdinBuffer
 		setup    func(c *run.Client)
 		expected []*Result
 		err      string
@@ -132,6 +138,42 @@ func TestCommand_Execute(t *testing.T) {
 			err:      "",
 		},
 
+		{
+			desc: "[stdin] passes the stdin buffer in place of the file",
+			command: &Command{
+				Template:      "test-linter --verbose",
+				InputType:     InputTypeStdin,
+				OutputFormat:  OutputFormatNone,
+				StdinFilename: render.MustCompile("--stdin-filename={{ .Path }}"),
+			},
+			paths: []string{
+				"testdata/txt/aaa.txt",
+				"testdata/txt/bbb.txt",
+			},
+			stdin: &StdinBuffer{
+				Path:    "testdata/txt/aaa.txt",
+				Content: []byte("unsaved content\n"),
+			},
+			setup: func(c *run.Client) {
+				c.RegisterStub(
+					run.MatchAll(
+						run.MatchString("test-linter --verbose --stdin-filename=testdata/txt/aaa.txt"),
+						run.MatchStdin("unsaved content\n"),
+					),
+					run.StringResponse(""),
+				)
+				c.RegisterStub(
+					run.MatchAll(
+						run.MatchString("test-linter --verbose --stdin-filename=testdata/txt/bbb.txt"),
+						run.MatchStdin("bbb content\n"),
+					),
+					run.StringResponse(""),
+				)
+			},
+			expected: []*Result{},
+			err:      "",
+		},
+
 		{
 			desc: "[variadic] runs command once per batch of paths",
 			command: &Command{
@@ -152,6 +194,249 @@ func TestCommand_Execute(t *testing.T) {
 			expected: []*Result{},
 			err:      "",
 		},
+
+		{
+			desc: "[variadic] passes the stdin buffer separately when stdin filename is set",
+			command: &Command{
+				Template:      "test-linter --verbose",
+				InputType:     InputTypeVariadic,
+				OutputFormat:  OutputFormatRegexp,
+				StdinFilename: render.MustCompile("--stdin --stdin-filename={{ .Path }}"),
+				ResultMappings: ResultMappings{
+					{
+						Pattern:   `(?P<file>[^:]+):(?P<line>\d+)`,
+						Path:      render.MustCompile("{{ .file }}"),
+						StartLine: render.MustCompile("{{ .line }}"),
+					},
+				},
+			},
+			paths: []string{
+				"testdata/txt/aaa.txt",
+				"testdata/txt/bbb.txt",
+			},
+			stdin: &StdinBuffer{
+				Path:    "testdata/txt/bbb.txt",
+				Content: []byte("unsaved content\n"),
+			},
+			setup: func(c *run.Client) {
+				c.RegisterStub(
+					run.MatchString("test-linter --verbose testdata/txt/aaa.txt"),
+					run.StringResponse(""),
+				)
+				c.RegisterStub(
+					run.MatchAll(
+						run.MatchString("test-linter --verbose --stdin --stdin-filename=testdata/txt/bbb.txt"),
+						run.MatchStdin("unsaved content\n"),
+					),
+					run.StringResponse("<stdin>:3\n"),
+				)
+			},
+			expected: []*Result{
+				{
+					Source:   "test-linter",
+					Location: ResultLocation{Path: "testdata/txt/bbb.txt", StartLine: 3},
+				},
+			},
+			err: "",
+		},
+		{
+			desc: "[variadic] checks a temp copy of the stdin buffer without a stdin filename",
+			command: &Command{
+				Template:     "test-linter --verbose",
+				InputType:    InputTypeVariadic,
+				OutputFormat: OutputFormatRegexp,
+				ResultMappings: ResultMappings{
+					{
+						Pattern:   `(?P<file>[^:]+):(?P<line>\d+)`,
+						Path:      render.MustCompile("{{ .file }}"),
+						StartLine: render.MustCompile("{{ .line }}"),
+					},
+				},
+			},
+			paths: []string{
+				"testdata/txt/aaa.txt",
+				"testdata/txt/bbb.txt",
+			},
+			stdin: &StdinBuffer{
+				Path:    "testdata/txt/bbb.txt",
+				Content: []byte("unsaved content\n"),
+			},
+			setup: func(c *run.Client) {
+				c.RegisterStub(
+					run.MatchString("test-linter --verbose testdata/txt/aaa.txt"),
+					run.StringResponse(""),
+				)
+				c.RegisterStub(
+					run.MatchRegexp(`^test-linter --verbose /.+/bbb\.txt$`),
+					func(cmd *run.Cmd) ([]byte, []byte, error) {
+						path := cmd.Args[len(cmd.Args)-1]
+						content, err := os.ReadFile(path)
+						if err != nil || string(content) != "unsaved content\n" {
+							return nil, nil, fmt.Errorf("unexpected temp file: %q, %w", content, err)
+						}
+						return []byte(path + ":3\n"), nil, nil
+					},
+				)
+			},
+			expected: []*Result{
+				{
+					Source:   "test-linter",
+					Location: ResultLocation{Path: "testdata/txt/bbb.txt", StartLine: 3},
+				},
+			},
+			err: "",
+		},
+		{
+			desc: "[variadic] only replaces stdin placeholders with the stdin path",
+			command: &Command{
+				Template:      "test-linter --verbose",
+				InputType:     InputTypeVariadic,
+				OutputFormat:  OutputFormatRegexp,
+				StdinFilename: render.MustCompile("--stdin-filename={{ .Path }}"),
+				ResultMappings: ResultMappings{
+					{
+						Pattern:   `(?P<file>[^:]+):(?P<line>\d+)`,
+						Path:      render.MustCompile("{{ .file }}"),
+						StartLine: render.MustCompile("{{ .line }}"),
+					},
+				},
+			},
+			paths: []string{
+				"testdata/txt/bbb.txt",
+			},
+			stdin: &StdinBuffer{
+				Path:    "testdata/txt/bbb.txt",
+				Content: []byte("unsaved content\n"),
+			},
+			setup: func(c *run.Client) {
+				c.RegisterStub(
+					run.MatchAll(
+						run.MatchString("test-linter --verbose --stdin-filename=testdata/txt/bbb.txt"),
+						run.MatchStdin("unsaved content\n"),
+					),
+					run.StringResponse("-:1\ntestdata/txt/aaa.txt:2\n"),
+				)
+			},
+			expected: []*Result{
+				{
+					Source:   "test-linter",
+					Location: ResultLocation{Path: "testdata/txt/bbb.txt", StartLine: 1},
+				},
+			},
+			err: "",
+		},
+
+		{
+			desc: "[file] parses the report written to output path",
+			command: &Command{
+				Template:     "test-linter --report={{ .OutputPath }}",
+				InputType:    InputTypeVariadic,
+				OutputType:   OutputTypeFile,
+				OutputPath:   render.MustCompile("{{ .TempDir }}/report.txt"),
+				OutputFormat: OutputFormatRegexp,
+				ResultMappings: ResultMappings{
+					{
+						Pattern:   `(?P<file>.*):(?P<line>\d+)`,
+						Path:      render.MustCompile("{{ .file }}"),
+						StartLine: render.MustCompile("{{ .line }}"),
+					},
+				},
+			},
+			paths: []string{
+				"testdata/txt/aaa.txt",
+			},
+			setup: func(c *run.Client) {
+				c.RegisterStub(
+					run.MatchRegexp(`^test-linter --report=.+/report\.txt testdata/txt/aaa\.txt$`),
+					func(cmd *run.Cmd) ([]byte, []byte, error) {
+						path := strings.TrimPrefix(cmd.Args[1], "--report=")
+						err := os.WriteFile(path, []byte("testdata/txt/aaa.txt:2\n"), 0600)
+						return []byte("progress noise\n"), nil, err
+					},
+				)
+			},
+			expected: []*Result{
+				{
+					Source:   "test-linter",
+					Location: ResultLocation{Path: "testdata/txt/aaa.txt", StartLine: 2},
+				},
+			},
+			err: "",
+		},
+		{
+			desc: "[file] treats a missing report as empty output",
+			command: &Command{
+				Template:     "test-linter --report={{ .OutputPath }}",
+				InputType:    InputTypeVariadic,
+				OutputType:   OutputTypeFile,
+				OutputPath:   render.MustCompile("{{ .TempDir }}/report.txt"),
+				OutputFormat: OutputFormatRegexp,
+				ResultMappings: ResultMappings{
+					{
+						Pattern: `(?P<file>.*):(?P<line>\d+)`,
+					},
+				},
+			},
+			paths: []string{
+				"testdata/txt/aaa.txt",
+			},
+			setup: func(c *run.Client) {
+				c.RegisterStub(
+					run.MatchRegexp(`^test-linter --report=.+/report\.txt`),
+					run.StringResponse("testdata/txt/aaa.txt:2\n"),
+				)
+			},
+			expected: []*Result{},
+			err:      "",
+		},
+		{
+			desc: "[file] returns errors running the command before parser errors",
+			command: &Command{
+				Template:     "test-linter",
+				InputType:    InputTypeVariadic,
+				OutputType:   OutputTypeFile,
+				OutputPath:   render.MustCompile("{{ .TempDir }}/report.txt"),
+				OutputFormat: OutputFormatRegexp,
+			},
+			paths: []string{
+				"testdata/txt/aaa.txt",
+			},
+			setup: func(c *run.Client) {
+				c.RegisterStub(
+					run.MatchString("test-linter testdata/txt/aaa.txt"),
+					run.ErrorResponse(errors.New("executable not found")),
+				)
+			},
+			expected: []*Result{},
+			err:      "executable not found",
+		},
+		{
+			desc: "[file] returns an error when output path is missing",
+			command: &Command{
+				Template:   "test-linter",
+				InputType:  InputTypeVariadic,
+				OutputType: OutputTypeFile,
+			},
+			paths: []string{
+				"testdata/txt/aaa.txt",
+			},
+			expected: []*Result{},
+			err:      "output_path is required",
+		},
+		{
+			desc: "[file] returns an error when the command template is malformed",
+			command: &Command{
+				Template:   "test-linter --report={{ .Unknown.Field }}",
+				InputType:  InputTypeVariadic,
+				OutputType: OutputTypeFile,
+				OutputPath: render.MustCompile("{{ .TempDir }}/report.txt"),
+			},
+			paths: []string{
+				"testdata/txt/aaa.txt",
+			},
+			expected: []*Result{},
+			err:      "invalid command",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.desc, func(t *testing.T) {
@@ -163,6 +448,9 @@ func TestCommand_Execute(t *testing.T) {
 			}
 
 			ctx := app.InitContext(context.Background())
+			if tt.stdin != nil {
+				ctx = WithStdinBuffer(ctx, tt.stdin)
+			}
 			actual, err := tt.command.Execute(ctx, "test-linter", ".", tt.paths)
 
 			if tt.err == "" {
@@ -273,3 +561,17 @@ func TestCommand_partition(t *testing.T) {
 		})
 	}
 }
+
+func TestTailBuffer(t *testing.T) {
+	buf := newTailBuffer(8)
+
+	n, err := buf.Write([]byte("abc"))
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, "abc", buf.String())
+
+	n, err = buf.Write([]byte("defghij"))
+	assert.NoError(t, err)
+	assert.Equal(t, 7, n)
+	assert.Equal(t, "cdefghij", buf.String())
+}
@@ -17,8 +17,9 @@ type Config struct {
 	LogLevel   LogLevel     `yaml:"log_level,omitempty"   default:"warn"`
 	Output     OutputConfig `yaml:"output,omitempty"`
 
-	Excludes   []string     `yaml:"excludes,omitempty"`
-	Processors []*Processor `yaml:"processors,omitempty"`
+	Excludes   []string        `yaml:"excludes,omitempty"`
+	Processors []*Processor    `yaml:"processors,omitempty"`
+	Rules      []*RuleOverride `yaml:"rules,omitempty"`
 }
 
 type OutputConfig struct {
@@ -29,6 +30,29 @@ type OutputConfig struct {
 	ShowURL         bool         `yaml:"show_url,omitempty"         default:"true"`
 	SyntaxHighlight bool         `yaml:"syntax_highlight,omitempty" default:"true"`
 	Severity        []string     `yaml:"severity,omitempty"         default:"[\"none\", \"info\", \"warning\", \"error\"]"` //nolint: lll
+	GroupBy         ResultGroup  `yaml:"group_by,omitempty"         default:"none"`
+	ShowSummary     bool         `yaml:"show_summary,omitempty"     default:"false"`
+	ContextBefore   int          `yaml:"context_before,omitempty"`
+	ContextAfter    int          `yaml:"context_after,omitempty"`
+
+	FailLevel                ResultLevel `yaml:"fail_level,omitempty"`
+	MaxWarnings              int         `yaml:"max_warnings,omitempty"               default:"-1"`
+	ReportUnusedSuppressions bool        `yaml:"report_unused_suppressions,omitempty"`
+
+	Template string          `yaml:"template,omitempty"`
+	Targets  []*OutputTarget `yaml:"targets,omitempty"`
+}
+
+// UnmarshalYAML allows `output` to be either a mapping of output settings,
+// or a list of output targets (shorthand for `output.targets`).
+func (oc *OutputConfig) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.SequenceNode {
+		return node.Decode(&oc.Targets)
+	}
+	// Decode into an alias type to avoid infinite recursion.
+	// Fields missing from the node retain their (default) values.
+	type plain OutputConfig
+	return node.Decode((*plain)(oc))
 }
 
 func NewConfig() *Config {
@@ -78,6 +78,22 @@ func TestNewConfigFromArgs(t *testing.T) {
 				assert.Equal(t, defaultConfig.LogLevel, config.LogLevel)
 			},
 		},
+		{
+			desc: "accepts a list of output targets",
+			args: []string{
+				"--config=" + configFixturePath("output-targets"),
+			},
+			expectation: func(t *testing.T, config *Config) {
+				t.Helper()
+				assert.Equal(t, []*OutputTarget{
+					{Format: ResultFormatTty},
+					{Format: ResultFormatSarif, Path: "reports/stylist.sarif"},
+				}, config.Output.Targets)
+				// Other output settings should retain their defaults.
+				assert.Equal(t, ResultFormatTty, config.Output.Format)
+				assert.Equal(t, true, config.Output.ShowContext)
+			},
+		},
 		{
 			desc: "returns an error when invalid config file",
 			args: []string{
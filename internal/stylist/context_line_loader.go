@@ -4,9 +4,11 @@ import (
 	"github.com/twelvelabs/stylist/internal/fsutils"
 )
 
-func NewContextLineLoader() *ContextLineLoader {
+// NewContextLineLoader returns a new context line loader.
+// Lines for the path of buf (if set) are loaded from buf.
+func NewContextLineLoader(buf *StdinBuffer) *ContextLineLoader {
 	return &ContextLineLoader{
-		lineCache: fsutils.NewLineCache(fsutils.NewFileCache()),
+		lineCache: newLineCache(buf),
 	}
 }
 
@@ -32,3 +34,33 @@ func (l *ContextLineLoader) Load(loc ResultLocation) ([]string, error) {
 
 	return lines, nil
 }
+
+// LoadSurrounding returns up to before lines preceding, and after lines
+// following, the lines of loc. The returned slices are nil when loc has
+// no path or line (i.e. there is nothing to surround), and non-nil otherwise.
+func (l *ContextLineLoader) LoadSurrounding(loc ResultLocation, before, after int) ([]string, []string, error) {
+	if loc.Path == "" || loc.StartLine == 0 {
+		return nil, nil, nil
+	}
+
+	lines, err := l.lineCache.GetLines(loc.Path)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Ignore the empty "line" following a trailing newline.
+	count := len(lines)
+	if count > 0 && lines[count-1] == "" {
+		count--
+	}
+
+	// Note: line numbers are 1-based, slice indices are 0-based.
+	start, end := loc.LineRange()
+	beforeStart := max(start-1-before, 0)
+	beforeEnd := min(max(start-1, 0), count)
+	afterStart := min(end, count)
+	afterEnd := min(end+after, count)
+
+	beforeLines := append([]string{}, lines[min(beforeStart, beforeEnd):beforeEnd]...)
+	afterLines := append([]string{}, lines[afterStart:afterEnd]...)
+	return beforeLines, afterLines, nil
+}
@@ -63,7 +63,7 @@ func TestContextLineLoader(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.desc, func(t *testing.T) {
-			actual, err := NewContextLineLoader().Load(tt.location)
+			actual, err := NewContextLineLoader(nil).Load(tt.location)
 
 			if tt.err == "" {
 				assert.NoError(t, err)
@@ -75,3 +75,71 @@ func TestContextLineLoader(t *testing.T) {
 		})
 	}
 }
+
+func TestContextLineLoader_LoadSurrounding(t *testing.T) {
+	tests := []struct {
+		desc     string
+		location ResultLocation
+		before   int
+		after    int
+		expected [][]string
+		err      string
+	}{
+		{
+			desc:     "returns nil when path is empty",
+			location: ResultLocation{},
+			before:   1,
+			after:    1,
+			expected: [][]string{nil, nil},
+		},
+		{
+			desc:     "returns nil when line is zero",
+			location: ResultLocation{Path: "testdata/example.txt"},
+			before:   1,
+			after:    1,
+			expected: [][]string{nil, nil},
+		},
+		{
+			desc:     "returns the surrounding lines",
+			location: ResultLocation{Path: "testdata/example.txt", StartLine: 2},
+			before:   1,
+			after:    1,
+			expected: [][]string{{"one"}, {"three"}},
+		},
+		{
+			desc:     "clamps to the start and end of the file",
+			location: ResultLocation{Path: "testdata/example.txt", StartLine: 1, EndLine: 2},
+			before:   5,
+			after:    5,
+			expected: [][]string{{}, {"three"}},
+		},
+		{
+			desc:     "returns empty slices when out of bounds",
+			location: ResultLocation{Path: "testdata/example.txt", StartLine: 99},
+			before:   1,
+			after:    1,
+			expected: [][]string{{}, {}},
+		},
+		{
+			desc:     "returns an error if path does not exist",
+			location: ResultLocation{Path: "testdata/does-not-exist.txt", StartLine: 1},
+			before:   1,
+			after:    1,
+			expected: [][]string{nil, nil},
+			err:      "no such file or directory",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			before, after, err := NewContextLineLoader(nil).LoadSurrounding(tt.location, tt.before, tt.after)
+
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+
+			assert.Equal(t, tt.expected, [][]string{before, after})
+		})
+	}
+}
@@ -14,12 +14,12 @@ type InputType string
 
 // OutputType represents where command output is sent.
 //
-// ENUM(stdout, stderr).
+// ENUM(stdout, stderr, file).
 type OutputType string
 
 // OutputFormat represents how to parse command output.
 //
-// ENUM(checkstyle, diff, json, none, regexp, sarif).
+// ENUM(checkstyle, diff, errorformat, json, jsonl, none, rdjson, rdjsonl, regexp, sarif, toml, xml, yaml).
 type OutputFormat string
 
 // ResultLevel represents the severity level of the result.
@@ -46,9 +46,14 @@ func CoerceResultLevel(value string) (ResultLevel, error) {
 
 // ResultFormat represents how to format the results.
 //
-// ENUM(checkstyle, json, sarif, tty).
+// ENUM(checkstyle, html, json, junit, markdown, rdjson, rdjsonl, sarif, template, tty).
 type ResultFormat string
 
+// ResultGroup represents how to group results in TTY output.
+//
+// ENUM(none, file, rule, source).
+type ResultGroup string
+
 // ResultPath configures the type of path to use in results.
 //
 // ENUM(absolute, relative).
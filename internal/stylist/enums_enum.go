@@ -266,14 +266,28 @@ const (
 	OutputFormatCheckstyle OutputFormat = "checkstyle"
 	// OutputFormatDiff is a OutputFormat of type diff.
 	OutputFormatDiff OutputFormat = "diff"
+	// OutputFormatErrorformat is a OutputFormat of type errorformat.
+	OutputFormatErrorformat OutputFormat = "errorformat"
 	// OutputFormatJson is a OutputFormat of type json.
 	OutputFormatJson OutputFormat = "json"
+	// OutputFormatJsonl is a OutputFormat of type jsonl.
+	OutputFormatJsonl OutputFormat = "jsonl"
 	// OutputFormatNone is a OutputFormat of type none.
 	OutputFormatNone OutputFormat = "none"
+	// OutputFormatRdjson is a OutputFormat of type rdjson.
+	OutputFormatRdjson OutputFormat = "rdjson"
+	// OutputFormatRdjsonl is a OutputFormat of type rdjsonl.
+	OutputFormatRdjsonl OutputFormat = "rdjsonl"
 	// OutputFormatRegexp is a OutputFormat of type regexp.
 	OutputFormatRegexp OutputFormat = "regexp"
 	// OutputFormatSarif is a OutputFormat of type sarif.
 	OutputFormatSarif OutputFormat = "sarif"
+	// OutputFormatToml is a OutputFormat of type toml.
+	OutputFormatToml OutputFormat = "toml"
+	// OutputFormatXml is a OutputFormat of type xml.
+	OutputFormatXml OutputFormat = "xml"
+	// OutputFormatYaml is a OutputFormat of type yaml.
+	OutputFormatYaml OutputFormat = "yaml"
 )
 
 var ErrInvalidOutputFormat = fmt.Errorf("not a valid OutputFormat, try [%s]", strings.Join(_OutputFormatNames, ", "))
@@ -281,10 +295,17 @@ var ErrInvalidOutputFormat = fmt.Errorf("not a valid OutputFormat, try [%s]", st
 var _OutputFormatNames = []string{
 	string(OutputFormatCheckstyle),
 	string(OutputFormatDiff),
+	string(OutputFormatErrorformat),
 	string(OutputFormatJson),
+	string(OutputFormatJsonl),
 	string(OutputFormatNone),
+	string(OutputFormatRdjson),
+	string(OutputFormatRdjsonl),
 	string(OutputFormatRegexp),
 	string(OutputFormatSarif),
+	string(OutputFormatToml),
+	string(OutputFormatXml),
+	string(OutputFormatYaml),
 }
 
 // OutputFormatNames returns a list of possible string values of OutputFormat.
@@ -307,12 +328,19 @@ func (x OutputFormat) IsValid() bool {
 }
 
 var _OutputFormatValue = map[string]OutputFormat{
-	"checkstyle": OutputFormatCheckstyle,
-	"diff":       OutputFormatDiff,
-	"json":       OutputFormatJson,
-	"none":       OutputFormatNone,
-	"regexp":     OutputFormatRegexp,
-	"sarif":      OutputFormatSarif,
+	"checkstyle":  OutputFormatCheckstyle,
+	"diff":        OutputFormatDiff,
+	"errorformat": OutputFormatErrorformat,
+	"json":        OutputFormatJson,
+	"jsonl":       OutputFormatJsonl,
+	"none":        OutputFormatNone,
+	"rdjson":      OutputFormatRdjson,
+	"rdjsonl":     OutputFormatRdjsonl,
+	"regexp":      OutputFormatRegexp,
+	"sarif":       OutputFormatSarif,
+	"toml":        OutputFormatToml,
+	"xml":         OutputFormatXml,
+	"yaml":        OutputFormatYaml,
 }
 
 // ParseOutputFormat attempts to convert a string to a OutputFormat.
@@ -360,6 +388,8 @@ const (
 	OutputTypeStdout OutputType = "stdout"
 	// OutputTypeStderr is a OutputType of type stderr.
 	OutputTypeStderr OutputType = "stderr"
+	// OutputTypeFile is a OutputType of type file.
+	OutputTypeFile OutputType = "file"
 )
 
 var ErrInvalidOutputType = fmt.Errorf("not a valid OutputType, try [%s]", strings.Join(_OutputTypeNames, ", "))
@@ -367,6 +397,7 @@ var ErrInvalidOutputType = fmt.Errorf("not a valid OutputType, try [%s]", string
 var _OutputTypeNames = []string{
 	string(OutputTypeStdout),
 	string(OutputTypeStderr),
+	string(OutputTypeFile),
 }
 
 // OutputTypeNames returns a list of possible string values of OutputType.
@@ -391,6 +422,7 @@ func (x OutputType) IsValid() bool {
 var _OutputTypeValue = map[string]OutputType{
 	"stdout": OutputTypeStdout,
 	"stderr": OutputTypeStderr,
+	"file":   OutputTypeFile,
 }
 
 // ParseOutputType attempts to convert a string to a OutputType.
@@ -436,10 +468,22 @@ func (x *OutputType) Type() string {
 const (
 	// ResultFormatCheckstyle is a ResultFormat of type checkstyle.
 	ResultFormatCheckstyle ResultFormat = "checkstyle"
+	// ResultFormatHtml is a ResultFormat of type html.
+	ResultFormatHtml ResultFormat = "html"
 	// ResultFormatJson is a ResultFormat of type json.
 	ResultFormatJson ResultFormat = "json"
+	// ResultFormatJunit is a ResultFormat of type junit.
+	ResultFormatJunit ResultFormat = "junit"
+	// ResultFormatMarkdown is a ResultFormat of type markdown.
+	ResultFormatMarkdown ResultFormat = "markdown"
+	// ResultFormatRdjson is a ResultFormat of type rdjson.
+	ResultFormatRdjson ResultFormat = "rdjson"
+	// ResultFormatRdjsonl is a ResultFormat of type rdjsonl.
+	ResultFormatRdjsonl ResultFormat = "rdjsonl"
 	// ResultFormatSarif is a ResultFormat of type sarif.
 	ResultFormatSarif ResultFormat = "sarif"
+	// ResultFormatTemplate is a ResultFormat of type template.
+	ResultFormatTemplate ResultFormat = "template"
 	// ResultFormatTty is a ResultFormat of type tty.
 	ResultFormatTty ResultFormat = "tty"
 )
@@ -448,8 +492,14 @@ var ErrInvalidResultFormat = fmt.Errorf("not a valid ResultFormat, try [%s]", st
 
 var _ResultFormatNames = []string{
 	string(ResultFormatCheckstyle),
+	string(ResultFormatHtml),
 	string(ResultFormatJson),
+	string(ResultFormatJunit),
+	string(ResultFormatMarkdown),
+	string(ResultFormatRdjson),
+	string(ResultFormatRdjsonl),
 	string(ResultFormatSarif),
+	string(ResultFormatTemplate),
 	string(ResultFormatTty),
 }
 
@@ -474,8 +524,14 @@ func (x ResultFormat) IsValid() bool {
 
 var _ResultFormatValue = map[string]ResultFormat{
 	"checkstyle": ResultFormatCheckstyle,
+	"html":       ResultFormatHtml,
 	"json":       ResultFormatJson,
+	"junit":      ResultFormatJunit,
+	"markdown":   ResultFormatMarkdown,
+	"rdjson":     ResultFormatRdjson,
+	"rdjsonl":    ResultFormatRdjsonl,
 	"sarif":      ResultFormatSarif,
+	"template":   ResultFormatTemplate,
 	"tty":        ResultFormatTty,
 }
 
@@ -519,6 +575,92 @@ func (x *ResultFormat) Type() string {
 	return "ResultFormat"
 }
 
+const (
+	// ResultGroupNone is a ResultGroup of type none.
+	ResultGroupNone ResultGroup = "none"
+	// ResultGroupFile is a ResultGroup of type file.
+	ResultGroupFile ResultGroup = "file"
+	// ResultGroupRule is a ResultGroup of type rule.
+	ResultGroupRule ResultGroup = "rule"
+	// ResultGroupSource is a ResultGroup of type source.
+	ResultGroupSource ResultGroup = "source"
+)
+
+var ErrInvalidResultGroup = fmt.Errorf("not a valid ResultGroup, try [%s]", strings.Join(_ResultGroupNames, ", "))
+
+var _ResultGroupNames = []string{
+	string(ResultGroupNone),
+	string(ResultGroupFile),
+	string(ResultGroupRule),
+	string(ResultGroupSource),
+}
+
+// ResultGroupNames returns a list of possible string values of ResultGroup.
+func ResultGroupNames() []string {
+	tmp := make([]string, len(_ResultGroupNames))
+	copy(tmp, _ResultGroupNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x ResultGroup) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x ResultGroup) IsValid() bool {
+	_, err := ParseResultGroup(string(x))
+	return err == nil
+}
+
+var _ResultGroupValue = map[string]ResultGroup{
+	"none":   ResultGroupNone,
+	"file":   ResultGroupFile,
+	"rule":   ResultGroupRule,
+	"source": ResultGroupSource,
+}
+
+// ParseResultGroup attempts to convert a string to a ResultGroup.
+func ParseResultGroup(name string) (ResultGroup, error) {
+	if x, ok := _ResultGroupValue[name]; ok {
+		return x, nil
+	}
+	return ResultGroup(""), fmt.Errorf("%s is %w", name, ErrInvalidResultGroup)
+}
+
+// MarshalText implements the text marshaller method.
+func (x ResultGroup) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *ResultGroup) UnmarshalText(text []byte) error {
+	tmp, err := ParseResultGroup(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// Set implements the Golang flag.Value interface func.
+func (x *ResultGroup) Set(val string) error {
+	v, err := ParseResultGroup(val)
+	*x = v
+	return err
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *ResultGroup) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *ResultGroup) Type() string {
+	return "ResultGroup"
+}
+
 const (
 	// ResultLevelNone is a ResultLevel of type None.
 	ResultLevelNone ResultLevel = iota
@@ -203,6 +203,27 @@ func TestResultFormat(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestResultGroup(t *testing.T) {
+	names := ResultGroupNames()
+	require.True(t, len(names) > 0)
+
+	name := names[0]
+	enum, _ := ParseResultGroup(name)
+	require.True(t, enum.IsValid())
+	require.Equal(t, enum, enum.Get())
+	require.NoError(t, enum.Set(enum.String()))
+
+	enumType := strings.TrimPrefix(fmt.Sprintf("%T", enum), "stylist.")
+	require.Equal(t, enumType, enum.Type())
+
+	marshalled, err := enum.MarshalText()
+	require.NoError(t, err)
+	err = enum.UnmarshalText(marshalled)
+	require.NoError(t, err)
+	err = enum.UnmarshalText([]byte{})
+	require.Error(t, err)
+}
+
 func TestResultPath(t *testing.T) {
 	names := ResultPathNames()
 	require.True(t, len(names) > 0)
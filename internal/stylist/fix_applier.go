@@ -0,0 +1,239 @@
+package stylist
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"unicode/utf8"
+)
+
+// NewFixApplier returns a new fix applier.
+func NewFixApplier() *FixApplier {
+	return &FixApplier{}
+}
+
+// FixApplier applies the fixes suggested by processors to the files on disk.
+type FixApplier struct {
+}
+
+// columnKind is the unit a fix's columns are measured in.
+// The non-default values match those of the SARIF `columnKind` property.
+type columnKind string
+
+const (
+	columnKindBytes             columnKind = ""
+	columnKindUTF16CodeUnits    columnKind = "utf16CodeUnits"
+	columnKindUnicodeCodePoints columnKind = "unicodeCodePoints"
+)
+
+// textEdit is a fix resolved to a byte range within a file.
+type textEdit struct {
+	start       int
+	end         int
+	replacement string
+}
+
+// isInsert returns true if the edit only inserts text.
+func (e textEdit) isInsert() bool {
+	return e.start == e.end
+}
+
+// overlaps returns true if the edits touch the same bytes
+// (or one inserts text where the other replaces it).
+// Multiple inserts at the same position do not overlap,
+// and are applied in the order they were added.
+func (e textEdit) overlaps(other textEdit) bool {
+	if e.start == other.start {
+		return !e.isInsert() || !other.isInsert()
+	}
+	return e.start < other.end && other.start < e.end
+}
+
+// Apply applies the fixes for each result and returns the results
+// that were fixed. Results are processed in order, and a result is skipped
+// entirely if any of its fixes overlap with those of a previous result.
+func (a *FixApplier) Apply(results []*Result) ([]*Result, error) {
+	contents := map[string][]byte{}
+	edits := map[string][]textEdit{}
+	paths := []string{}
+	applied := []*Result{}
+
+	for _, result := range results {
+		if len(result.Fixes) == 0 {
+			continue
+		}
+
+		// Resolve all the fixes for the result before accepting any of them.
+		pending := map[string][]textEdit{}
+		ok := true
+		for _, fix := range result.Fixes {
+			path := fix.Location.Path
+			if path == "" {
+				path = result.Location.Path
+			}
+			if _, found := contents[path]; !found {
+				content, err := os.ReadFile(path)
+				if err != nil {
+					return nil, fmt.Errorf("fix applier: %w", err)
+				}
+				contents[path] = content
+				paths = append(paths, path)
+			}
+
+			edit, err := resolveTextEdit(contents[path], fix)
+			if err != nil {
+				return nil, fmt.Errorf("fix applier: %s: %w", path, err)
+			}
+			for _, other := range edits[path] {
+				ok = ok && !edit.overlaps(other)
+			}
+			for _, other := range pending[path] {
+				ok = ok && !edit.overlaps(other)
+			}
+			pending[path] = append(pending[path], edit)
+		}
+		if !ok {
+			continue
+		}
+
+		for path, pendingEdits := range pending {
+			edits[path] = append(edits[path], pendingEdits...)
+		}
+		applied = append(applied, result)
+	}
+
+	for _, path := range paths {
+		if len(edits[path]) == 0 {
+			continue
+		}
+		err := writeTextEdits(path, contents[path], edits[path])
+		if err != nil {
+			return nil, fmt.Errorf("fix applier: %w", err)
+		}
+	}
+
+	return applied, nil
+}
+
+// Applies the (non-overlapping) edits to content and writes it to path.
+func writeTextEdits(path string, content []byte, edits []textEdit) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	// Apply from the end of the file so earlier offsets remain valid.
+	// Edits at the same position are applied last-to-first so that
+	// inserts end up in the order they were added.
+	edits = slices.Clone(edits)
+	slices.Reverse(edits)
+	sort.SliceStable(edits, func(i, j int) bool {
+		return edits[i].start > edits[j].start
+	})
+	buf := bytes.Clone(content)
+	for _, edit := range edits {
+		buf = append(buf[:edit.start:edit.start],
+			append([]byte(edit.replacement), buf[edit.end:]...)...)
+	}
+
+	return os.WriteFile(path, buf, info.Mode().Perm())
+}
+
+// Converts the fix into a byte range within content.
+func resolveTextEdit(content []byte, fix *ResultFix) (textEdit, error) {
+	edit := textEdit{replacement: fix.Replacement}
+
+	if fix.Location.StartLine == 0 {
+		edit.start = fix.ByteOffset
+		edit.end = fix.ByteOffset + fix.ByteLength
+	} else {
+		lineStarts := []int{0}
+		for idx, b := range content {
+			if b == '\n' {
+				lineStarts = append(lineStarts, idx+1)
+			}
+		}
+
+		var err error
+		startLine, endLine := fix.Location.LineRange()
+		edit.start, err = lineColumnOffset(
+			content, lineStarts, startLine, fix.Location.StartColumn, fix.columnKind, false,
+		)
+		if err != nil {
+			return edit, err
+		}
+		edit.end, err = lineColumnOffset(
+			content, lineStarts, endLine, fix.Location.EndColumn, fix.columnKind, true,
+		)
+		if err != nil {
+			return edit, err
+		}
+	}
+
+	if edit.start < 0 || edit.end > len(content) || edit.start > edit.end {
+		return edit, fmt.Errorf("invalid fix range: %d-%d", edit.start, edit.end)
+	}
+	return edit, nil
+}
+
+// Returns the byte offset of the 1-based line and column
+// (measured in kind units).
+// A column of 0 means the start of the line, or when isEnd is true,
+// the end of the line (excluding the newline).
+func lineColumnOffset(
+	content []byte, lineStarts []int, line int, column int, kind columnKind, isEnd bool,
+) (int, error) {
+	if line == len(lineStarts)+1 && column <= 1 {
+		// Allow addressing the position just past a final line
+		// that has no trailing newline.
+		return len(content), nil
+	}
+	if line < 1 || line > len(lineStarts) {
+		return 0, fmt.Errorf("line out of range: %d", line)
+	}
+
+	start := lineStarts[line-1]
+	end := len(content)
+	if line < len(lineStarts) {
+		end = lineStarts[line] - 1
+		if end > start && content[end-1] == '\r' {
+			end--
+		}
+	}
+
+	if column == 0 {
+		if isEnd {
+			return end, nil
+		}
+		return start, nil
+	}
+
+	offset := start + byteColumn(content[start:end], column, kind) - 1
+	if offset > end {
+		return 0, fmt.Errorf("column out of range: %d:%d", line, column)
+	}
+	return offset, nil
+}
+
+// Converts the 1-based column in line from kind units to bytes.
+// Columns past the end of the line stay past it.
+func byteColumn(line []byte, column int, kind columnKind) int {
+	if kind == columnKindBytes {
+		return column
+	}
+	units := 0
+	for idx := 0; idx < len(line); {
+		if units >= column-1 {
+			return idx + 1
+		}
+		r, size := utf8.DecodeRune(line[idx:])
+		units++
+		if kind == columnKindUTF16CodeUnits && r > 0xFFFF {
+			units++ // encoded as a surrogate pair
+		}
+		idx += size
+	}
+	return len(line) + column - units
+}
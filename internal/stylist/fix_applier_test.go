@@ -0,0 +1,264 @@
+package stylist
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/twelvelabs/termite/testutil"
+)
+
+func TestNewFixApplier(t *testing.T) {
+	assert.IsType(t, &FixApplier{}, NewFixApplier())
+}
+
+func TestFixApplier_Apply(t *testing.T) {
+	tests := []struct {
+		desc     string
+		content  string
+		results  []*Result
+		expected string
+		applied  int
+		err      string
+	}{
+		{
+			desc:     "does nothing when there are no fixes",
+			content:  "one\ntwo\nthree\n",
+			results:  []*Result{{Location: ResultLocation{Path: "example.txt"}}},
+			expected: "one\ntwo\nthree\n",
+			applied:  0,
+		},
+		{
+			desc:    "applies line and column fixes",
+			content: "one\ntwo\nthree\n",
+			results: []*Result{
+				{
+					Location: ResultLocation{Path: "example.txt"},
+					Fixes: []*ResultFix{
+						{
+							Location: ResultLocation{
+								StartLine:   2,
+								StartColumn: 2,
+								EndLine:     2,
+								EndColumn:   4,
+							},
+							Replacement: "OO",
+						},
+					},
+				},
+			},
+			expected: "one\ntOO\nthree\n",
+			applied:  1,
+		},
+		{
+			desc:    "treats missing columns as the whole line",
+			content: "one\ntwo\nthree\n",
+			results: []*Result{
+				{
+					Location: ResultLocation{Path: "example.txt"},
+					Fixes: []*ResultFix{
+						{
+							Location:    ResultLocation{StartLine: 3},
+							Replacement: "THREE",
+						},
+					},
+				},
+			},
+			expected: "one\ntwo\nTHREE\n",
+			applied:  1,
+		},
+		{
+			desc:    "inserts zero-length column fixes",
+			content: "one\ntwo\nthree\n",
+			results: []*Result{
+				{
+					Location: ResultLocation{Path: "example.txt"},
+					Fixes: []*ResultFix{
+						{
+							Location: ResultLocation{
+								StartLine:   2,
+								StartColumn: 2,
+								EndLine:     2,
+								EndColumn:   2,
+							},
+							Replacement: "-",
+						},
+					},
+				},
+			},
+			expected: "one\nt-wo\nthree\n",
+			applied:  1,
+		},
+		{
+			desc:    "converts columns measured in utf-16 code units",
+			content: "a\U0001F600é bad\n",
+			results: []*Result{
+				{
+					Location: ResultLocation{Path: "example.txt"},
+					Fixes: []*ResultFix{
+						{
+							// "a" is 1 unit, the emoji 2, "é" 1, and the space 1.
+							Location: ResultLocation{
+								StartLine:   1,
+								StartColumn: 6,
+								EndLine:     1,
+								EndColumn:   9,
+							},
+							Replacement: "good",
+							columnKind:  columnKindUTF16CodeUnits,
+						},
+					},
+				},
+			},
+			expected: "a\U0001F600é good\n",
+			applied:  1,
+		},
+		{
+			desc:    "converts columns measured in code points",
+			content: "a\U0001F600é bad\n",
+			results: []*Result{
+				{
+					Location: ResultLocation{Path: "example.txt"},
+					Fixes: []*ResultFix{
+						{
+							Location: ResultLocation{
+								StartLine:   1,
+								StartColumn: 5,
+								EndLine:     1,
+								EndColumn:   8,
+							},
+							Replacement: "good",
+							columnKind:  columnKindUnicodeCodePoints,
+						},
+					},
+				},
+			},
+			expected: "a\U0001F600é good\n",
+			applied:  1,
+		},
+		{
+			desc:    "applies byte offset fixes",
+			content: "one\ntwo\nthree\n",
+			results: []*Result{
+				{
+					Location: ResultLocation{Path: "example.txt"},
+					Fixes: []*ResultFix{
+						{ByteOffset: 0, ByteLength: 4, Replacement: ""},
+						{ByteOffset: 14, ByteLength: 0, Replacement: "four\n"},
+					},
+				},
+			},
+			expected: "two\nthree\nfour\n",
+			applied:  1,
+		},
+		{
+			desc:    "preserves a missing newline at the end of the file",
+			content: "one\ntwo",
+			results: []*Result{
+				{
+					Location: ResultLocation{Path: "example.txt"},
+					Fixes: []*ResultFix{
+						{
+							Location: ResultLocation{
+								StartLine:   2,
+								StartColumn: 1,
+								EndLine:     3,
+								EndColumn:   1,
+							},
+							Replacement: "TWO",
+						},
+					},
+				},
+			},
+			expected: "one\nTWO",
+			applied:  1,
+		},
+		{
+			desc:    "skips results that overlap with previous fixes",
+			content: "one\ntwo\nthree\n",
+			results: []*Result{
+				{
+					Location: ResultLocation{Path: "example.txt"},
+					Fixes: []*ResultFix{
+						{ByteOffset: 0, ByteLength: 3, Replacement: "ONE"},
+					},
+				},
+				{
+					Location: ResultLocation{Path: "example.txt"},
+					Fixes: []*ResultFix{
+						{ByteOffset: 2, ByteLength: 3, Replacement: "nope"},
+					},
+				},
+				{
+					Location: ResultLocation{Path: "example.txt"},
+					Fixes: []*ResultFix{
+						{ByteOffset: 4, ByteLength: 3, Replacement: "TWO"},
+					},
+				},
+			},
+			expected: "ONE\nTWO\nthree\n",
+			applied:  2,
+		},
+		{
+			desc:    "applies inserts at the same position in order",
+			content: "one\ntwo\nthree\n",
+			results: []*Result{
+				{
+					Location: ResultLocation{Path: "example.txt"},
+					Fixes: []*ResultFix{
+						{ByteOffset: 4, ByteLength: 0, Replacement: "a\n"},
+						{ByteOffset: 4, ByteLength: 0, Replacement: "b\n"},
+					},
+				},
+				{
+					Location: ResultLocation{Path: "example.txt"},
+					Fixes: []*ResultFix{
+						{ByteOffset: 4, ByteLength: 0, Replacement: "c\n"},
+					},
+				},
+				{
+					Location: ResultLocation{Path: "example.txt"},
+					Fixes: []*ResultFix{
+						{ByteOffset: 4, ByteLength: 3, Replacement: "nope"},
+					},
+				},
+			},
+			expected: "one\na\nb\nc\ntwo\nthree\n",
+			applied:  2,
+		},
+		{
+			desc:    "returns an error when the range is out of bounds",
+			content: "one\ntwo\nthree\n",
+			results: []*Result{
+				{
+					Location: ResultLocation{Path: "example.txt"},
+					Fixes: []*ResultFix{
+						{
+							Location:    ResultLocation{StartLine: 10},
+							Replacement: "nope",
+						},
+					},
+				},
+			},
+			expected: "one\ntwo\nthree\n",
+			err:      "line out of range: 10",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			testutil.InTempDir(t, func(dir string) {
+				testutil.WriteFile(t, "example.txt", []byte(tt.content), 0600)
+
+				applied, err := NewFixApplier().Apply(tt.results)
+
+				if tt.err == "" {
+					assert.NoError(t, err)
+				} else {
+					assert.ErrorContains(t, err, tt.err)
+				}
+
+				assert.Len(t, applied, tt.applied)
+				testutil.AssertFilePath(t, "example.txt", tt.expected)
+			})
+		})
+	}
+}
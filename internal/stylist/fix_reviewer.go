@@ -0,0 +1,266 @@
+package stylist
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strings"
+
+	"github.com/twelvelabs/termite/ui"
+
+	"github.com/twelvelabs/stylist/internal/textdiff"
+)
+
+const (
+	reviewAccept = "accept"
+	reviewSkip   = "skip"
+	reviewQuit   = "quit"
+	// Number of unchanged lines to show around each change.
+	reviewContextLines = 3
+)
+
+// NewFixReviewer returns a new fix reviewer.
+func NewFixReviewer(ios *ui.IOStreams, prompter ui.Prompter, config *Config) *FixReviewer {
+	cwd, _ := os.Getwd()
+	return &FixReviewer{
+		ios:       ios,
+		prompter:  prompter,
+		adjuster:  NewPathAdjuster(cwd, config.Output.Paths),
+		snapshots: map[string]*fileSnapshot{},
+	}
+}
+
+// FixReviewer prompts the user to accept or skip each change made to files
+// since they were snapshotted, and reverts the skipped changes.
+type FixReviewer struct {
+	ios       *ui.IOStreams
+	prompter  ui.Prompter
+	adjuster  *PathAdjuster
+	dir       string
+	paths     []string
+	snapshots map[string]*fileSnapshot
+}
+
+// fileSnapshot records the state of a file before it was fixed.
+type fileSnapshot struct {
+	// Path to a copy of the original content.
+	// Empty when the file did not exist.
+	copy string
+	mode fs.FileMode
+}
+
+func (s *fileSnapshot) exists() bool {
+	return s.copy != ""
+}
+
+// Snapshot copies the current content of paths to a temp dir
+// so that changes to them can be reviewed.
+// Paths that do not exist yet are reviewed as new files.
+func (r *FixReviewer) Snapshot(paths ...string) error {
+	for _, path := range paths {
+		if _, ok := r.snapshots[path]; ok {
+			continue
+		}
+		snapshot, err := r.copyFile(path)
+		if err != nil {
+			return fmt.Errorf("fix reviewer: %w", err)
+		}
+		r.snapshots[path] = snapshot
+		r.paths = append(r.paths, path)
+	}
+	return nil
+}
+
+// Copies path into the snapshot dir.
+func (r *FixReviewer) copyFile(path string) (*fileSnapshot, error) {
+	src, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &fileSnapshot{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+
+	info, err := src.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	if r.dir == "" {
+		r.dir, err = os.MkdirTemp("", "stylist-review-")
+		if err != nil {
+			return nil, err
+		}
+	}
+	dst, err := os.CreateTemp(r.dir, "snapshot-")
+	if err != nil {
+		return nil, err
+	}
+	defer dst.Close()
+
+	if _, err := io.Copy(dst, src); err != nil {
+		return nil, err
+	}
+	return &fileSnapshot{copy: dst.Name(), mode: info.Mode().Perm()}, nil
+}
+
+// Close removes the snapshots.
+func (r *FixReviewer) Close() error {
+	if r.dir == "" {
+		return nil
+	}
+	return os.RemoveAll(r.dir)
+}
+
+// Review shows the diff for each change made to the snapshotted paths
+// and prompts the user to accept it. Skipped changes are reverted.
+// Choosing to quit skips all remaining changes.
+// Files that were created or deleted are reviewed as a single change.
+// Any created paths that were not snapshotted are assumed to be new files.
+// Returns the paths that had changes reverted.
+func (r *FixReviewer) Review(created ...string) ([]string, error) {
+	for _, path := range created {
+		if _, ok := r.snapshots[path]; !ok {
+			r.snapshots[path] = &fileSnapshot{}
+			r.paths = append(r.paths, path)
+		}
+	}
+
+	reverted := []string{}
+	quit := false
+	for _, path := range r.paths {
+		snapshot := r.snapshots[path]
+		before, err := readOptionalFile(snapshot.copy)
+		if err != nil {
+			return nil, fmt.Errorf("fix reviewer: %w", err)
+		}
+		after, err := readOptionalFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("fix reviewer: %w", err)
+		}
+
+		label := ""
+		orig := textdiff.SplitLines(string(before))
+		updated := textdiff.SplitLines(string(after))
+		var hunks []*textdiff.Hunk
+		switch {
+		case !snapshot.exists() && after == nil:
+			continue
+		case !snapshot.exists():
+			label = "created"
+			hunks = []*textdiff.Hunk{{NewLines: updated}}
+		case after == nil:
+			label = "deleted"
+			hunks = []*textdiff.Hunk{{OrigLines: orig}}
+		case bytes.Equal(before, after):
+			continue
+		default:
+			hunks = textdiff.Lines(orig, updated)
+		}
+
+		accepted := []*textdiff.Hunk{}
+		for _, hunk := range hunks {
+			if quit {
+				break
+			}
+			r.printHunk(path, label, orig, hunk)
+			choice, err := r.prompter.Select(
+				"Apply this change?",
+				[]string{reviewAccept, reviewSkip, reviewQuit},
+				reviewAccept,
+			)
+			if err != nil {
+				return nil, err
+			}
+			switch choice {
+			case reviewAccept:
+				accepted = append(accepted, hunk)
+			case reviewQuit:
+				quit = true
+			}
+		}
+
+		if len(accepted) == len(hunks) {
+			continue // nothing to revert
+		}
+		reverted = append(reverted, path)
+		if err := r.revert(path, snapshot, orig, accepted); err != nil {
+			return nil, fmt.Errorf("fix reviewer: %w", err)
+		}
+	}
+	return reverted, nil
+}
+
+// Reverts the changes made to path, other than the accepted hunks.
+func (r *FixReviewer) revert(
+	path string, snapshot *fileSnapshot, orig []string, accepted []*textdiff.Hunk,
+) error {
+	if !snapshot.exists() {
+		return os.Remove(path)
+	}
+	mode := snapshot.mode
+	if info, err := os.Stat(path); err == nil {
+		mode = info.Mode().Perm()
+	}
+	updated := strings.Join(textdiff.Apply(orig, accepted), "")
+	return os.WriteFile(path, []byte(updated), mode)
+}
+
+// Returns the content of path, or nil if it does not exist.
+func readOptionalFile(path string) ([]byte, error) {
+	if path == "" {
+		return nil, nil
+	}
+	content, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if content == nil {
+		// Distinguish empty files from missing ones.
+		content = []byte{}
+	}
+	return content, nil
+}
+
+func (r *FixReviewer) printHunk(path string, label string, orig []string, hunk *textdiff.Hunk) {
+	formatter := r.ios.Formatter()
+
+	displayPath, err := r.adjuster.Convert(path)
+	if err != nil {
+		displayPath = path
+	}
+	if label != "" {
+		displayPath = fmt.Sprintf("%s (%s)", displayPath, label)
+	}
+
+	before := max(hunk.OrigStart-reviewContextLines, 0)
+	after := min(hunk.OrigStart+len(hunk.OrigLines)+reviewContextLines, len(orig))
+	leading := hunk.OrigStart - before
+	trailing := after - (hunk.OrigStart + len(hunk.OrigLines))
+
+	fmt.Fprintln(r.ios.Out, formatter.Bold(displayPath))
+	fmt.Fprintln(r.ios.Out, formatter.Cyan(fmt.Sprintf(
+		"@@ -%d,%d +%d,%d @@",
+		before+1, leading+len(hunk.OrigLines)+trailing,
+		hunk.NewStart-leading+1, leading+len(hunk.NewLines)+trailing,
+	)))
+	for _, line := range orig[before:hunk.OrigStart] {
+		fmt.Fprintln(r.ios.Out, " "+strings.TrimRight(line, "\r\n"))
+	}
+	for _, line := range hunk.OrigLines {
+		fmt.Fprintln(r.ios.Out, formatter.Red("-"+strings.TrimRight(line, "\r\n")))
+	}
+	for _, line := range hunk.NewLines {
+		fmt.Fprintln(r.ios.Out, formatter.Green("+"+strings.TrimRight(line, "\r\n")))
+	}
+	for _, line := range orig[hunk.OrigStart+len(hunk.OrigLines) : after] {
+		fmt.Fprintln(r.ios.Out, " "+strings.TrimRight(line, "\r\n"))
+	}
+}
@@ -0,0 +1,197 @@
+package stylist
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twelvelabs/termite/testutil"
+	"github.com/twelvelabs/termite/ui"
+)
+
+func TestFixReviewer_Review(t *testing.T) {
+	tests := []struct {
+		desc      string
+		responses []string
+		expected  string
+		reverted  []string
+	}{
+		{
+			desc:      "keeps accepted changes",
+			responses: []string{reviewAccept, reviewAccept},
+			expected:  "one\nTWO\nthree\nfour\nFIVE\n",
+			reverted:  []string{},
+		},
+		{
+			desc:      "reverts skipped changes",
+			responses: []string{reviewSkip, reviewAccept},
+			expected:  "one\ntwo\nthree\nfour\nFIVE\n",
+			reverted:  []string{"example.txt"},
+		},
+		{
+			desc:      "reverts all remaining changes on quit",
+			responses: []string{reviewQuit},
+			expected:  "one\ntwo\nthree\nfour\nfive\n",
+			reverted:  []string{"example.txt"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			app := NewTestApp()
+			prompter := app.Prompter.(*ui.StubPrompter)
+			defer prompter.VerifyStubs(t)
+			for _, response := range tt.responses {
+				prompter.RegisterStub(
+					ui.MatchSelect("Apply this change?"),
+					ui.RespondString(response),
+				)
+			}
+
+			testutil.InTempDir(t, func(dir string) {
+				testutil.WriteFile(t, "example.txt", []byte("one\ntwo\nthree\nfour\nfive\n"), 0600)
+
+				reviewer := NewFixReviewer(app.IO, app.Prompter, app.Config)
+				require.NoError(t, reviewer.Snapshot("example.txt"))
+
+				err := os.WriteFile("example.txt", []byte("one\nTWO\nthree\nfour\nFIVE\n"), 0600)
+				require.NoError(t, err)
+
+				reverted, err := reviewer.Review()
+				assert.NoError(t, err)
+				assert.Equal(t, tt.reverted, reverted)
+				testutil.AssertFilePath(t, "example.txt", tt.expected)
+				assert.Contains(t, app.IO.Out.String(), "-two")
+				assert.Contains(t, app.IO.Out.String(), "+TWO")
+			})
+		})
+	}
+}
+
+func TestFixReviewer_Review_CreatedFiles(t *testing.T) {
+	tests := []struct {
+		desc     string
+		response string
+		exists   bool
+	}{
+		{
+			desc:     "keeps accepted new files",
+			response: reviewAccept,
+			exists:   true,
+		},
+		{
+			desc:     "removes skipped new files",
+			response: reviewSkip,
+			exists:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			app := NewTestApp()
+			prompter := app.Prompter.(*ui.StubPrompter)
+			defer prompter.VerifyStubs(t)
+			for range 2 {
+				prompter.RegisterStub(
+					ui.MatchSelect("Apply this change?"),
+					ui.RespondString(tt.response),
+				)
+			}
+
+			testutil.InTempDir(t, func(dir string) {
+				reviewer := NewFixReviewer(app.IO, app.Prompter, app.Config)
+				defer reviewer.Close()
+				require.NoError(t, reviewer.Snapshot("snapshotted.txt"))
+
+				testutil.WriteFile(t, "snapshotted.txt", []byte("one\n"), 0600)
+				testutil.WriteFile(t, "created.txt", []byte("two\n"), 0600)
+
+				_, err := reviewer.Review("created.txt")
+				assert.NoError(t, err)
+
+				assert.Contains(t, app.IO.Out.String(), "snapshotted.txt (created)")
+				assert.Contains(t, app.IO.Out.String(), "created.txt (created)")
+				if tt.exists {
+					assert.FileExists(t, "snapshotted.txt")
+					assert.FileExists(t, "created.txt")
+				} else {
+					assert.NoFileExists(t, "snapshotted.txt")
+					assert.NoFileExists(t, "created.txt")
+				}
+			})
+		})
+	}
+}
+
+func TestFixReviewer_Review_DeletedFiles(t *testing.T) {
+	tests := []struct {
+		desc     string
+		response string
+		exists   bool
+	}{
+		{
+			desc:     "keeps accepted deletions",
+			response: reviewAccept,
+			exists:   false,
+		},
+		{
+			desc:     "restores skipped deletions",
+			response: reviewSkip,
+			exists:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			app := NewTestApp()
+			prompter := app.Prompter.(*ui.StubPrompter)
+			defer prompter.VerifyStubs(t)
+			prompter.RegisterStub(
+				ui.MatchSelect("Apply this change?"),
+				ui.RespondString(tt.response),
+			)
+
+			testutil.InTempDir(t, func(dir string) {
+				testutil.WriteFile(t, "example.txt", []byte("one\n"), 0600)
+
+				reviewer := NewFixReviewer(app.IO, app.Prompter, app.Config)
+				defer reviewer.Close()
+				require.NoError(t, reviewer.Snapshot("example.txt"))
+				require.NoError(t, os.Remove("example.txt"))
+
+				_, err := reviewer.Review()
+				assert.NoError(t, err)
+
+				assert.Contains(t, app.IO.Out.String(), "example.txt (deleted)")
+				if tt.exists {
+					testutil.AssertFilePath(t, "example.txt", "one\n")
+				} else {
+					assert.NoFileExists(t, "example.txt")
+				}
+			})
+		})
+	}
+}
+
+func TestFixReviewer_Close(t *testing.T) {
+	app := NewTestApp()
+	testutil.InTempDir(t, func(dir string) {
+		testutil.WriteFile(t, "example.txt", []byte("one\n"), 0600)
+
+		reviewer := NewFixReviewer(app.IO, app.Prompter, app.Config)
+		require.NoError(t, reviewer.Snapshot("example.txt"))
+		require.DirExists(t, reviewer.dir)
+
+		assert.NoError(t, reviewer.Close())
+		assert.NoDirExists(t, reviewer.dir)
+	})
+}
+
+func TestFixReviewer_Snapshot_WhenUnreadable(t *testing.T) {
+	app := NewTestApp()
+	testutil.InTempDir(t, func(dir string) {
+		require.NoError(t, os.Mkdir("example", 0700))
+
+		reviewer := NewFixReviewer(app.IO, app.Prompter, app.Config)
+		defer reviewer.Close()
+		assert.ErrorContains(t, reviewer.Snapshot("example"), "fix reviewer")
+	})
+}
@@ -1,19 +1,34 @@
 package stylist
 
 import (
+	"bufio"
 	"bytes"
+	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
+	"net/url"
+	"path"
 	"regexp"
+	"regexp/syntax"
+	"slices"
+	"strconv"
 	"strings"
 
+	"github.com/antchfx/xmlquery"
+	"github.com/antchfx/xpath"
 	"github.com/owenrumney/go-sarif/v2/sarif"
+	"github.com/pelletier/go-toml/v2"
+	"github.com/reviewdog/errorformat"
 	"github.com/sourcegraph/go-diff/diff"
 	"github.com/tidwall/gjson"
+	"github.com/twelvelabs/termite/render"
+	"gopkg.in/yaml.v3"
 
 	"github.com/twelvelabs/stylist/internal/checkstyle"
 	"github.com/twelvelabs/stylist/internal/fsutils"
+	"github.com/twelvelabs/stylist/internal/rdjson"
 )
 
 var (
@@ -25,9 +40,124 @@ var (
 
 // OutputParser is the interface that wraps the Parse method.
 //
-// Parse parses command output into a slice of results.
+// Parse reads command output incrementally and calls emit
+// for each result as soon as it has been parsed.
+// Parsing stops at the first error returned by emit.
 type OutputParser interface {
-	Parse(output CommandOutput, mapping ResultMapping) ([]*Result, error)
+	Parse(output CommandOutput, mapping ResultMapping, emit ResultEmitter) error
+}
+
+// ResultEmitter is called by an OutputParser for each parsed result.
+type ResultEmitter func(r *Result) error
+
+// ParseOutput parses output using parser and returns the collected results.
+func ParseOutput(parser OutputParser, output CommandOutput, mapping ResultMapping) ([]*Result, error) {
+	var results []*Result
+	err := parser.Parse(output, mapping, func(r *Result) error {
+		results = append(results, r)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// MultiMappingOutputParser is implemented by parsers that try each
+// mapping in order against every item of the output (i.e. each line),
+// so that the first matching mapping wins.
+type MultiMappingOutputParser interface {
+	ParseMappings(output CommandOutput, mappings ResultMappings, emit ResultEmitter) error
+}
+
+// ParseMappings parses output using each of the mappings.
+//
+// Parsers implementing MultiMappingOutputParser handle all the mappings
+// in a single pass. Otherwise the output is read into memory once, and each
+// mapping (in order) parses a new reader over that same buffer.
+func ParseMappings(
+	parser OutputParser, output CommandOutput, mappings ResultMappings, emit ResultEmitter,
+) error {
+	switch {
+	case len(mappings) == 0:
+		return parser.Parse(output, ResultMapping{}, emit)
+	case len(mappings) == 1 || ignoresMapping(parser):
+		return parser.Parse(output, mappings[0], emit)
+	}
+	if mp, ok := parser.(MultiMappingOutputParser); ok {
+		return mp.ParseMappings(output, mappings, emit)
+	}
+
+	// The output can only be consumed once, so buffer it for all mappings.
+	buf, err := io.ReadAll(output.Content)
+	if err != nil {
+		return err
+	}
+	for _, mapping := range mappings {
+		output.Content = bytes.NewReader(buf)
+		if err := parser.Parse(output, mapping, emit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns true if parser emits the same results for every mapping
+// (i.e. structured formats, and diffs which only use the pattern of the
+// first mapping), in which case parsing the output more than once
+// would duplicate results.
+func ignoresMapping(parser OutputParser) bool {
+	switch parser.(type) {
+	case *CheckstyleOutputParser, *DiffOutputParser, *NoneOutputParser,
+		*RDJSONOutputParser, *RDJSONLOutputParser, *SarifOutputParser:
+		return true
+	default:
+		return false
+	}
+}
+
+// Converts item using mapping and emits the result.
+func emitMapped(mapping ResultMapping, item resultData, emit ResultEmitter) error {
+	result, err := mapping.ToResult(item)
+	if err != nil {
+		return err
+	}
+	return emit(result)
+}
+
+// Returns a scanner that reads r a line at a time,
+// allowing for lines up to 10MB.
+func newLineScanner(r io.Reader) *bufio.Scanner {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), 10*1024*1024)
+	return scanner
+}
+
+// ansiStripReader strips ANSI escape codes from the underlying
+// reader a line at a time.
+type ansiStripReader struct {
+	r   *bufio.Reader
+	buf []byte
+}
+
+func newANSIStripReader(r io.Reader) *ansiStripReader {
+	return &ansiStripReader{r: bufio.NewReader(r)}
+}
+
+func (a *ansiStripReader) Read(p []byte) (int, error) {
+	for len(a.buf) == 0 {
+		line, err := a.r.ReadBytes('\n')
+		a.buf = ansiRegexp.ReplaceAll(line, []byte(""))
+		if err != nil {
+			if len(a.buf) > 0 {
+				break // return the data now, the error on the next read.
+			}
+			return 0, err
+		}
+	}
+	n := copy(p, a.buf)
+	a.buf = a.buf[n:]
+	return n, nil
 }
 
 // NewOutputParser returns the appropriate parser for the given output type.
@@ -37,14 +167,28 @@ func NewOutputParser(format OutputFormat) OutputParser { //nolint:ireturn
 		return &CheckstyleOutputParser{}
 	case OutputFormatDiff:
 		return &DiffOutputParser{}
+	case OutputFormatErrorformat:
+		return &ErrorformatOutputParser{}
 	case OutputFormatJson:
 		return &JSONOutputParser{}
+	case OutputFormatJsonl:
+		return &JSONLOutputParser{}
 	case OutputFormatNone:
 		return &NoneOutputParser{}
+	case OutputFormatRdjson:
+		return &RDJSONOutputParser{}
+	case OutputFormatRdjsonl:
+		return &RDJSONLOutputParser{}
 	case OutputFormatRegexp:
 		return &RegexpOutputParser{}
 	case OutputFormatSarif:
 		return &SarifOutputParser{}
+	case OutputFormatToml:
+		return &TOMLOutputParser{}
+	case OutputFormatXml:
+		return &XMLOutputParser{}
+	case OutputFormatYaml:
+		return &YAMLOutputParser{}
 	default:
 		panic(fmt.Sprintf("unknown output format: %s", format))
 	}
@@ -58,34 +202,55 @@ func NewOutputParser(format OutputFormat) OutputParser { //nolint:ireturn
 type CheckstyleOutputParser struct {
 }
 
-func (p *CheckstyleOutputParser) Parse(output CommandOutput, _ ResultMapping) ([]*Result, error) {
-	// Read the content.
-	buf, err := io.ReadAll(output.Content)
-	if err != nil {
-		return nil, err
-	}
-	content := ansiRegexp.ReplaceAll(buf, []byte(""))
-	if len(content) == 0 {
-		return nil, nil // nothing to parse
-	}
+// Parse parses command output, emitting the results for each
+// file element as it is decoded.
+func (p *CheckstyleOutputParser) Parse(output CommandOutput, _ ResultMapping, emit ResultEmitter) error {
+	decoder := xml.NewDecoder(newANSIStripReader(output.Content))
 
-	// Parse.
-	csDoc := &checkstyle.CSResult{}
-	err = xml.Unmarshal(content, csDoc)
-	if err != nil {
-		return nil, fmt.Errorf("invalid checkstyle XML: %w", err)
-	}
+	sawRoot := false
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("invalid checkstyle XML: %w", err)
+		}
+
+		if data, ok := token.(xml.CharData); ok && !sawRoot && len(bytes.TrimSpace(data)) > 0 {
+			return fmt.Errorf("invalid checkstyle XML: unexpected text before root element")
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if !sawRoot {
+			if start.Name.Local != "checkstyle" {
+				return fmt.Errorf(
+					"invalid checkstyle XML: expected element type <checkstyle> but have <%s>",
+					start.Name.Local,
+				)
+			}
+			sawRoot = true
+			continue
+		}
+		if start.Name.Local != "file" {
+			if err := decoder.Skip(); err != nil {
+				return fmt.Errorf("invalid checkstyle XML: %w", err)
+			}
+			continue
+		}
 
-	// Map to Result structs.
-	results := []*Result{}
-	for _, file := range csDoc.Files {
-		path := file.Name
+		file := &checkstyle.CSFile{}
+		if err := decoder.DecodeElement(file, &start); err != nil {
+			return fmt.Errorf("invalid checkstyle XML: %w", err)
+		}
 		for _, e := range file.Errors {
 			level, _ := CoerceResultLevel(e.Severity)
 			result := &Result{
 				Level: level,
 				Location: ResultLocation{
-					Path:        path,
+					Path:        file.Name,
 					StartLine:   e.Line,
 					StartColumn: e.Column,
 				},
@@ -95,11 +260,11 @@ func (p *CheckstyleOutputParser) Parse(output CommandOutput, _ ResultMapping) ([
 					Description: e.Message,
 				},
 			}
-			results = append(results, result)
+			if err := emit(result); err != nil {
+				return err
+			}
 		}
 	}
-
-	return results, nil
 }
 
 /*
@@ -107,78 +272,328 @@ func (p *CheckstyleOutputParser) Parse(output CommandOutput, _ ResultMapping) ([
 **/
 
 // DiffOutputParser parses unified diffs.
+//
+// By default, a result is emitted for each hunk. Set the mapping pattern
+// to "file" to emit a single result per file instead.
 type DiffOutputParser struct {
 }
 
-// Parse parses command output into a slice of results.
-func (p *DiffOutputParser) Parse(output CommandOutput, _ ResultMapping) ([]*Result, error) {
-	// Read the content.
-	buf, err := io.ReadAll(output.Content)
-	if err != nil {
-		return nil, err
+const (
+	diffPatternHunk = "hunk"
+	diffPatternFile = "file"
+)
+
+// Parse parses command output, emitting each result as it is parsed.
+func (p *DiffOutputParser) Parse(output CommandOutput, mapping ResultMapping, emit ResultEmitter) error {
+	perFile := false
+	switch mapping.Pattern {
+	case "", diffPatternHunk:
+	case diffPatternFile:
+		perFile = true
+	default:
+		return fmt.Errorf(
+			"invalid diff pattern %q: must be %q or %q",
+			mapping.Pattern, diffPatternHunk, diffPatternFile,
+		)
 	}
-	content := ansiRegexp.ReplaceAll(buf, []byte(""))
-	if len(content) == 0 {
-		return nil, nil // nothing to parse
+
+	reader := diff.NewMultiFileDiffReader(newANSIStripReader(output.Content))
+	for {
+		d, err := reader.ReadFile()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("invalid diff: %w", err)
+		}
+		if perFile || len(d.Hunks) == 0 {
+			if err := emit(resultFromFileDiff(d)); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, hunk := range d.Hunks {
+			if err := emit(resultFromHunk(diffPath(d), hunk)); err != nil {
+				return err
+			}
+		}
 	}
+}
 
-	// Parse.
-	diffs, err := diff.ParseMultiFileDiff(content)
+// Maps a single file diff to a `Result` struct.
+func resultFromFileDiff(d *diff.FileDiff) *Result {
+	var startLine int
+	var contextLines []string
+	var fixes []*ResultFix
+
+	if len(d.Hunks) > 0 {
+		startLine, _ = hunkChangedLines(d.Hunks[0])
+
+		// Printing just the hunks (vs full diff) so we don't have
+		// redundant file names at the top of the context.
+		contextLines = hunkContextLines(d.Hunks...)
+
+		// Each hunk can also be applied as a fix.
+		for _, hunk := range d.Hunks {
+			fixes = append(fixes, resultFixFromHunk(hunk))
+		}
+	}
+
+	return newDiffResult(diffPath(d), startLine, 0, contextLines, fixes)
+}
+
+// Maps a single hunk to a `Result` struct.
+func resultFromHunk(path string, hunk *diff.Hunk) *Result {
+	startLine, endLine := hunkChangedLines(hunk)
+	return newDiffResult(
+		path,
+		startLine,
+		endLine,
+		hunkContextLines(hunk),
+		[]*ResultFix{resultFixFromHunk(hunk)},
+	)
+}
+
+func newDiffResult(
+	path string, startLine, endLine int, contextLines []string, fixes []*ResultFix,
+) *Result {
+	return &Result{
+		Level: ResultLevelError,
+		Location: ResultLocation{
+			Path:      path,
+			StartLine: startLine,
+			EndLine:   endLine,
+		},
+		Rule: ResultRule{
+			ID:          "diff",
+			Name:        "diff",
+			Description: "Formatting error",
+		},
+		ContextLines: contextLines,
+		ContextLang:  "diff",
+		Fixes:        fixes,
+	}
+}
+
+// Returns the path of the file being diffed.
+func diffPath(d *diff.FileDiff) string {
+	path := d.NewName
+	// Some diffs prefix the file path - strip that out.
+	if strings.HasPrefix(d.NewName, "new/") &&
+		(strings.HasPrefix(d.OrigName, "old/") ||
+			strings.HasPrefix(d.OrigName, "orig/")) {
+		path = strings.TrimPrefix(d.NewName, "new/")
+	}
+	return path
+}
+
+// Returns the first and last lines in the original file changed by hunk.
+// Hunks often start and end w/ a few context lines, so those are skipped.
+// Insertions are attributed to the line they are inserted before.
+func hunkChangedLines(hunk *diff.Hunk) (int, int) {
+	startLine, endLine := 0, 0
+	origLine := int(hunk.OrigStartLine)
+	if hunk.OrigLines == 0 {
+		// Hunks that only add lines reference the line _preceding_ the insertion.
+		origLine++
+	}
+	for _, line := range bytes.Split(hunk.Body, []byte{'\n'}) {
+		if len(line) > 0 && line[0] == '\\' {
+			continue // i.e. "\ No newline at end of file"
+		}
+		if len(line) == 0 || (line[0] != '+' && line[0] != '-') {
+			origLine++
+			continue
+		}
+		if startLine == 0 {
+			startLine = origLine
+		}
+		if line[0] == '-' {
+			endLine = origLine
+			origLine++
+		}
+	}
+	endLine = max(endLine, startLine)
+
+	// Insertions at the end of the file would otherwise be past the last line.
+	if hunk.OrigLines > 0 {
+		lastLine := int(hunk.OrigStartLine + hunk.OrigLines - 1)
+		startLine = min(startLine, lastLine)
+		endLine = min(endLine, lastLine)
+	}
+	return startLine, endLine
+}
+
+// Returns the printed hunks, minus the trailing newline, split into lines.
+func hunkContextLines(hunks ...*diff.Hunk) []string {
+	printed, _ := diff.PrintHunks(hunks)
+	return strings.Split(strings.TrimSuffix(string(printed), "\n"), "\n")
+}
+
+// Returns a fix that replaces the original lines in the hunk with the new ones.
+func resultFixFromHunk(hunk *diff.Hunk) *ResultFix {
+	startLine := int(hunk.OrigStartLine)
+	if hunk.OrigLines == 0 {
+		// Hunks that only add lines reference the line _preceding_ the insertion.
+		startLine++
+	}
+
+	// The parser consumes any "\ No newline at end of file" markers,
+	// trimming the newline from the preceding line when it's from the new file.
+	// So the final line of the replacement only ends w/ a newline
+	// if the new file does.
+	replacement := &strings.Builder{}
+	for _, line := range strings.SplitAfter(string(hunk.Body), "\n") {
+		if line == "" {
+			continue
+		}
+		switch line[0] {
+		case ' ', '+':
+			replacement.WriteString(line[1:])
+		case '\n':
+			// Some tools strip the leading space from blank context lines.
+			replacement.WriteString(line)
+		}
+	}
+
+	return &ResultFix{
+		Location: ResultLocation{
+			StartLine:   startLine,
+			StartColumn: 1,
+			EndLine:     startLine + int(hunk.OrigLines),
+			EndColumn:   1,
+		},
+		Replacement: replacement.String(),
+	}
+}
+
+/*
+* ErrorformatOutputParser
+**/
+
+// ErrorformatOutputParser parses text output using Vim errorformat
+// (efm) strings. The mapping pattern holds one efm per line.
+//
+// Each entry exposes the following keys to the mapping templates:
+// file, line, end_line, column, end_column, type, level, number,
+// message, and lines. When a mapping template is not defined,
+// a sensible default is used (i.e. path defaults to "{{ .file }}").
+type ErrorformatOutputParser struct {
+}
+
+// Parse parses command output, emitting each result as it is parsed.
+func (p *ErrorformatOutputParser) Parse(output CommandOutput, mapping ResultMapping, emit ResultEmitter) error {
+	// Validate the errorformat pattern(s).
+	efms := splitErrorformats(mapping.Pattern)
+	if len(efms) == 0 {
+		return fmt.Errorf("mapping pattern is required when output format is errorformat")
+	}
+	efm, err := newErrorformat(efms)
 	if err != nil {
-		return nil, fmt.Errorf("invalid diff: %w", err)
-	}
-
-	// Map the diffs to a slice of `Result` structs.
-	results := []*Result{}
-	for _, d := range diffs {
-		var startLine int
-		var contextLines []string
-
-		if len(d.Hunks) > 0 {
-			// Hunks often start w/ a few preceding context lines.
-			// Calculate the actual start of the changeset.
-			changeStart := 0
-			bodyLines := bytes.Split(d.Hunks[0].Body, []byte{'\n'})
-			for _, line := range bodyLines {
-				if len(line) > 0 && (line[0] == '+' || line[0] == '-') {
-					break
-				}
-				changeStart++
-			}
-			startLine = int(d.Hunks[0].OrigStartLine) + changeStart
+		return fmt.Errorf("mapping pattern: %w", err)
+	}
+	mapping = errorformatMapping(mapping)
 
-			// Printing just the hunks (vs full diff) so we don't have
-			// redundant file names at the top of the context.
-			hunks, _ := diff.PrintHunks(d.Hunks)
-			contextLines = strings.Split(strings.TrimSuffix(string(hunks), "\n"), "\n")
+	// Emit each valid quickfix entry as it is scanned.
+	reader := &errReader{r: newANSIStripReader(output.Content)}
+	scanner := efm.NewScanner(reader)
+	for scanner.Scan() {
+		entry := scanner.Entry()
+		if !entry.Valid {
+			continue
+		}
+		if err := emitMapped(mapping, resultDataFromErrorformatEntry(entry), emit); err != nil {
+			return err
 		}
+	}
+	return reader.err
+}
+
+// errReader records the first non-EOF read error, since
+// the errorformat scanner silently stops reading on errors.
+type errReader struct {
+	r   io.Reader
+	err error
+}
+
+func (e *errReader) Read(p []byte) (int, error) {
+	n, err := e.r.Read(p)
+	if err != nil && !errors.Is(err, io.EOF) && e.err == nil {
+		e.err = err
+	}
+	return n, err
+}
 
-		path := d.NewName
-		// Some diffs prefix the file path - strip that out.
-		if strings.HasPrefix(d.NewName, "new/") &&
-			(strings.HasPrefix(d.OrigName, "old/") ||
-				strings.HasPrefix(d.OrigName, "orig/")) {
-			path = strings.TrimPrefix(d.NewName, "new/")
+// Wraps errorformat.NewErrorformat, which panics on some malformed
+// patterns (i.e. a trailing "%"), so that it always returns an error.
+func newErrorformat(efms []string) (efm *errorformat.Errorformat, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invalid errorformat: %v", r)
 		}
+	}()
+	return errorformat.NewErrorformat(efms)
+}
 
-		result := &Result{
-			Level: ResultLevelError,
-			Location: ResultLocation{
-				Path:      path,
-				StartLine: startLine,
-			},
-			Rule: ResultRule{
-				ID:          "diff",
-				Name:        "diff",
-				Description: "Formatting error",
-			},
-			ContextLines: contextLines,
-			ContextLang:  "diff",
+// Returns the non-blank errorformat strings in pattern (one per line).
+func splitErrorformats(pattern string) []string {
+	efms := []string{}
+	for _, line := range strings.Split(pattern, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			efms = append(efms, line)
 		}
-		results = append(results, result)
 	}
+	return efms
+}
 
-	return results, nil
+func resultDataFromErrorformatEntry(entry *errorformat.Entry) resultData {
+	etype := ""
+	if entry.Type != 0 {
+		etype = string(entry.Type)
+	}
+	level := "error"
+	switch strings.ToLower(etype) {
+	case "w":
+		level = "warning"
+	case "i", "n":
+		level = "info"
+	}
+	return resultData{
+		"file":       entry.Filename,
+		"line":       entry.Lnum,
+		"end_line":   entry.EndLnum,
+		"column":     entry.Col,
+		"end_column": entry.EndCol,
+		"type":       etype,
+		"level":      level,
+		"number":     entry.Nr,
+		"message":    entry.Text,
+		"lines":      entry.Lines,
+	}
+}
+
+// Returns a copy of mapping with defaults for any undefined templates.
+func errorformatMapping(mapping ResultMapping) ResultMapping {
+	defaults := []struct {
+		field **render.Template
+		value string
+	}{
+		{&mapping.Level, "{{ .level }}"},
+		{&mapping.Path, "{{ .file }}"},
+		{&mapping.StartLine, "{{ .line }}"},
+		{&mapping.StartColumn, "{{ .column }}"},
+		{&mapping.EndLine, "{{ .end_line }}"},
+		{&mapping.EndColumn, "{{ .end_column }}"},
+		{&mapping.RuleID, "{{ if .number }}{{ .number }}{{ end }}"},
+		{&mapping.RuleName, "{{ if .number }}{{ .number }}{{ end }}"},
+		{&mapping.RuleDescription, "{{ .message }}"},
+	}
+	for _, d := range defaults {
+		if *d.field == nil {
+			*d.field = render.MustCompile(d.value)
+		}
+	}
+	return mapping
 }
 
 /*
@@ -186,205 +601,1174 @@ func (p *DiffOutputParser) Parse(output CommandOutput, _ ResultMapping) ([]*Resu
 **/
 
 // JSONOutputParser parses JSON formatted output.
+//
+// When the mapping pattern is empty (or "@this") the output must be
+// an array of objects, which are decoded and emitted one at a time.
+// Any other pattern is a GJSON path that requires the entire document,
+// so the output is buffered before being parsed.
 type JSONOutputParser struct {
 }
 
-// Parse parses command output into a slice of results.
-func (p *JSONOutputParser) Parse(output CommandOutput, mapping ResultMapping) ([]*Result, error) {
+// Parse parses command output, emitting each result as it is parsed.
+func (p *JSONOutputParser) Parse(output CommandOutput, mapping ResultMapping, emit ResultEmitter) error {
+	pattern := "@this"
+	if mapping.Pattern != "" {
+		pattern = mapping.Pattern
+	}
+
+	content := bufio.NewReader(output.Content)
+	if pattern == "@this" && p.peekArray(content) {
+		return p.parseStream(content, mapping, emit)
+	}
+	output.Content = content
+	return p.parseBuffered(output, mapping, pattern, emit)
+}
+
+// Returns true if the first non-whitespace byte is the start of an array.
+func (p *JSONOutputParser) peekArray(r *bufio.Reader) bool {
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return false
+		}
+		switch b {
+		case ' ', '\t', '\r', '\n':
+			continue
+		default:
+			_ = r.UnreadByte()
+			return b == '['
+		}
+	}
+}
+
+// Decodes the elements of a root JSON array one at a time.
+func (p *JSONOutputParser) parseStream(r io.Reader, mapping ResultMapping, emit ResultEmitter) error {
+	decoder := json.NewDecoder(r)
+
+	// Consume the opening bracket.
+	if _, err := decoder.Token(); err != nil {
+		return fmt.Errorf("invalid json: %w", err)
+	}
+
+	for idx := 0; decoder.More(); idx++ {
+		var value any
+		if err := decoder.Decode(&value); err != nil {
+			return fmt.Errorf("invalid json: %w", err)
+		}
+		item, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("invalid output: pattern=@this.%v is not an object", idx)
+		}
+		if err := emitMapped(mapping, resultData(item), emit); err != nil {
+			return err
+		}
+	}
+
+	// Consume the closing bracket so truncated output is reported.
+	if _, err := decoder.Token(); err != nil {
+		return fmt.Errorf("invalid json: %w", err)
+	}
+	return nil
+}
+
+// Buffers the entire document and evaluates the GJSON pattern.
+func (p *JSONOutputParser) parseBuffered(
+	output CommandOutput, mapping ResultMapping, pattern string, emit ResultEmitter,
+) error {
 	buf := &bytes.Buffer{}
 	_, err := buf.ReadFrom(output.Content)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	json := buf.String()
 	if json == "" {
 		// No results
-		return nil, nil
+		return nil
 	}
 
 	// Ensure valid JSON
 	if !gjson.Valid(json) {
-		return nil, fmt.Errorf("invalid json: %s", json)
+		return fmt.Errorf("invalid json: %s", json)
 	}
 
-	// Parse the JSON and return the data @ pattern.
-	// Note: `@this` is how GJSON addresses the root element.
-	pattern := "@this"
-	if mapping.Pattern != "" {
-		pattern = mapping.Pattern
-	}
-	result := gjson.Get(json, pattern)
+	return emitGJSON(json, pattern, mapping, emit)
+}
 
-	// Transform the GJSON results into resultData
-	items := []resultData{}
+// Evaluates the GJSON pattern against a JSON document,
+// emitting a result for each object in the resulting array.
+func emitGJSON(json string, pattern string, mapping ResultMapping, emit ResultEmitter) error {
+	result := gjson.Get(json, pattern)
 	if !result.IsArray() {
-		return nil, fmt.Errorf(
+		return fmt.Errorf(
 			"invalid output: pattern=%v is not an array, json=%v",
 			pattern, json,
 		)
 	}
 	for idx, r := range result.Array() {
 		if !r.IsObject() {
-			return nil, fmt.Errorf(
+			return fmt.Errorf(
 				"invalid output: pattern=%v.%v is not an object, json=%v",
 				pattern, idx, json,
 			)
 		}
 		item := r.Value().(map[string]any)
-		items = append(items, resultData(item))
+		if err := emitMapped(mapping, resultData(item), emit); err != nil {
+			return err
+		}
 	}
-
-	// Transform the resultData into `Result` structs.
-	return mapping.ToResultSlice(items)
+	return nil
 }
 
 /*
-* NoneOutputParser
+* JSONLOutputParser
 **/
 
-// NoneOutputParser is a noop parser for commands that produce no output.
-type NoneOutputParser struct {
+// JSONLOutputParser parses JSON Lines (NDJSON) formatted output.
+// Each line is parsed as an independent JSON document and
+// lines that are not valid JSON are skipped.
+//
+// The optional mapping pattern is a GJSON path evaluated against each line.
+// It may resolve to an object or an array of objects. Lines where the path
+// does not exist are skipped. When there are multiple mappings, each line
+// uses the first mapping whose path exists.
+type JSONLOutputParser struct {
 }
 
-// Parse parses command output into a slice of results.
-func (p *NoneOutputParser) Parse(_ CommandOutput, _ ResultMapping) ([]*Result, error) {
-	return nil, nil
+// Parse parses command output, emitting each result as it is parsed.
+func (p *JSONLOutputParser) Parse(output CommandOutput, mapping ResultMapping, emit ResultEmitter) error {
+	return p.ParseMappings(output, ResultMappings{mapping}, emit)
 }
 
-/*
-* RegexpOutputParser
-**/
-
-// RegexpOutputParser parses arbitrary text output using regular expressions.
-type RegexpOutputParser struct {
+// ParseMappings parses command output, trying each mapping in order for every line.
+func (p *JSONLOutputParser) ParseMappings(
+	output CommandOutput, mappings ResultMappings, emit ResultEmitter,
+) error {
+	scanner := newLineScanner(output.Content)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(ansiRegexp.ReplaceAll(scanner.Bytes(), []byte("")))
+		if len(line) == 0 || !gjson.ValidBytes(line) {
+			continue // blank or non-JSON noise (scalars are skipped below)
+		}
+		for _, mapping := range mappings {
+			matched, err := p.parseLine(line, mapping, emit)
+			if err != nil {
+				return err
+			}
+			if matched {
+				break
+			}
+		}
+	}
+	return scanner.Err()
 }
 
-// Parse parses command output into a slice of results.
-func (p *RegexpOutputParser) Parse(output CommandOutput, mapping ResultMapping) ([]*Result, error) {
-	// Validate the regexp pattern.
-	if mapping.Pattern == "" {
-		return nil, fmt.Errorf("mapping pattern is required when output format is regexp")
-	}
-	r, err := regexp.Compile(mapping.Pattern)
-	if err != nil {
-		return nil, fmt.Errorf("mapping pattern: %w", err)
+// Emits the results at the mapping pattern in line.
+// Returns false if the pattern does not exist or is not an object or array.
+func (p *JSONLOutputParser) parseLine(line []byte, mapping ResultMapping, emit ResultEmitter) (bool, error) {
+	pattern := "@this"
+	if mapping.Pattern != "" {
+		pattern = mapping.Pattern
 	}
 
-	// Read the content.
-	buf, err := io.ReadAll(output.Content)
-	if err != nil {
-		return nil, err
-	}
-	content := ansiRegexp.ReplaceAllString(string(buf), "")
-	if content == "" {
-		return nil, nil // nothing to parse
+	result := gjson.GetBytes(line, pattern)
+	switch {
+	case !result.Exists():
+		return false, nil
+	case result.IsObject():
+		return true, emitMapped(mapping, resultData(result.Value().(map[string]any)), emit)
+	case result.IsArray():
+		for idx, r := range result.Array() {
+			if !r.IsObject() {
+				return true, fmt.Errorf(
+					"invalid output: pattern=%v.%v is not an object, json=%s",
+					pattern, idx, line,
+				)
+			}
+			if err := emitMapped(mapping, resultData(r.Value().(map[string]any)), emit); err != nil {
+				return true, err
+			}
+		}
+		return true, nil
+	default:
+		// Scalars (i.e. `"progress..."` status lines) aren't results.
+		return false, nil
 	}
+}
 
-	// Run the regexp
-	matches := r.FindAllStringSubmatch(content, -1)
-	if len(matches) == 0 {
-		return nil, nil // nothing found
-	}
-	keys := r.SubexpNames()
+/*
+* NoneOutputParser
+**/
 
-	// Convert the regexp matches into a slice of resultData maps.
-	items := []resultData{}
-	for _, match := range matches {
-		item := resultData{}
-		for i := 1; i < len(keys); i++ {
-			item[keys[i]] = match[i]
-		}
-		items = append(items, item)
-	}
+// NoneOutputParser is a noop parser for commands that produce no output.
+type NoneOutputParser struct {
+}
 
-	// Transform the resultData into `Result` structs.
-	return mapping.ToResultSlice(items)
+// Parse parses command output, emitting each result as it is parsed.
+func (p *NoneOutputParser) Parse(_ CommandOutput, _ ResultMapping, _ ResultEmitter) error {
+	return nil
 }
 
 /*
-* SarifOutputParser
+* RDJSONOutputParser
 **/
 
-// SarifOutputParser parses SARIF formatted output.
-type SarifOutputParser struct {
+// RDJSONOutputParser parses reviewdog rdjson formatted output.
+// The document severity applies to every diagnostic,
+// so the entire output is buffered before being parsed.
+type RDJSONOutputParser struct {
 }
 
-// Parse parses command output into a slice of results.
-func (p *SarifOutputParser) Parse(output CommandOutput, _ ResultMapping) ([]*Result, error) {
+// Parse parses command output, emitting each result as it is parsed.
+func (p *RDJSONOutputParser) Parse(output CommandOutput, _ ResultMapping, emit ResultEmitter) error {
 	// Read the content.
 	buf, err := io.ReadAll(output.Content)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	content := ansiRegexp.ReplaceAllString(string(buf), "")
-	if content == "" {
-		return nil, nil // nothing to parse
+	content := bytes.TrimSpace(ansiRegexp.ReplaceAll(buf, []byte("")))
+	if len(content) == 0 {
+		return nil // nothing to parse
 	}
 
 	// Parse.
-	report, err := sarif.FromString(content)
+	doc := &rdjson.RDDiagnosticResult{}
+	err = json.Unmarshal(content, doc)
 	if err != nil {
-		return nil, fmt.Errorf("invalid sarif: %w", err)
+		return fmt.Errorf("invalid rdjson: %w", err)
 	}
 
-	// Map the report to a slice of `Result` structs.
-	issues := []*Result{}
-	for _, run := range report.Runs {
-		for _, result := range run.Results {
-			level, err := CoerceResultLevel(*result.Level)
-			if err != nil {
-				return nil, fmt.Errorf("invalid sarif level: %w", err)
-			}
+	// Map the diagnostics to `Result` structs.
+	for _, d := range doc.Diagnostics {
+		if d.Severity == "" {
+			d.Severity = doc.Severity
+		}
+		if err := emit(resultFromRDDiagnostic(d)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-			rule := ResultRule{}
-			rule.ID = *result.RuleID
-			rule.Name = *result.RuleID
-			rule.Description = *result.Message.Text
+/*
+* RDJSONLOutputParser
+**/
 
-			loc := ResultLocation{}
-			if len(result.Locations) > 0 {
-				loc, err = resultLocationFromSarif(result.Locations[0])
-				if err != nil {
-					return nil, fmt.Errorf("invalid sarif location: %w", err)
-				}
-			}
+// RDJSONLOutputParser parses reviewdog rdjsonl formatted output
+// (one rdjson diagnostic per line).
+type RDJSONLOutputParser struct {
+}
 
-			issue := &Result{
-				Level:    level,
-				Location: loc,
-				Rule:     rule,
-			}
+// Parse parses command output, emitting each result as it is parsed.
+func (p *RDJSONLOutputParser) Parse(output CommandOutput, _ ResultMapping, emit ResultEmitter) error {
+	scanner := newLineScanner(output.Content)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(ansiRegexp.ReplaceAll(scanner.Bytes(), []byte("")))
+		if len(line) == 0 {
+			continue
+		}
 
-			issues = append(issues, issue)
+		d := &rdjson.RDDiagnostic{}
+		if err := json.Unmarshal(line, d); err != nil {
+			return fmt.Errorf("invalid rdjsonl: %w", err)
+		}
+		if err := emit(resultFromRDDiagnostic(d)); err != nil {
+			return err
 		}
 	}
-
-	return issues, nil
+	return scanner.Err()
 }
 
-func resultLocationFromSarif(loc *sarif.Location) (ResultLocation, error) {
-	var err error
-
-	resultLocation := ResultLocation{}
-
-	pl := loc.PhysicalLocation
-	if pl != nil {
-		al := pl.ArtifactLocation
-		if al != nil {
-			resultLocation.Path, err = fsutils.RelativePath(*al.URI)
+func resultFromRDDiagnostic(d *rdjson.RDDiagnostic) *Result {
+	result := &Result{
+		Level: resultLevelFromRDSeverity(d.Severity),
+		Rule: ResultRule{
+			Description: d.Message,
+		},
+	}
+	if d.Code != nil {
+		result.Rule.ID = d.Code.Value
+		result.Rule.Name = d.Code.Value
+		result.Rule.URI = d.Code.URL
+	}
+	if d.Location != nil {
+		result.Location = resultLocationFromRDRange(d.Location.Range)
+		result.Location.Path = d.Location.Path
+	}
+	for _, s := range d.Suggestions {
+		if s.Range == nil || s.Range.Start == nil {
+			continue
+		}
+		fix := &ResultFix{
+			Location:    resultLocationFromRDRange(s.Range),
+			Replacement: s.Text,
+		}
+		fix.Location.Path = result.Location.Path
+		result.Fixes = append(result.Fixes, fix)
+	}
+	return result
+}
+
+func resultLocationFromRDRange(r *rdjson.RDRange) ResultLocation {
+	loc := ResultLocation{}
+	if r == nil {
+		return loc
+	}
+	if r.Start != nil {
+		loc.StartLine = r.Start.Line
+		loc.StartColumn = r.Start.Column
+	}
+	if r.End != nil {
+		loc.EndLine = r.End.Line
+		loc.EndColumn = r.End.Column
+	} else {
+		// An omitted end is a zero-length range at start (i.e. an insertion).
+		loc.EndLine = loc.StartLine
+		loc.EndColumn = loc.StartColumn
+	}
+	return loc
+}
+
+func resultLevelFromRDSeverity(severity string) ResultLevel {
+	switch severity {
+	case rdjson.SeverityError:
+		return ResultLevelError
+	case rdjson.SeverityWarning:
+		return ResultLevelWarning
+	case rdjson.SeverityInfo:
+		return ResultLevelInfo
+	default:
+		return ResultLevelNone
+	}
+}
+
+/*
+* RegexpOutputParser
+**/
+
+// RegexpOutputParser parses arbitrary text output using regular expressions.
+//
+// Patterns are matched against the entire output, so they may span
+// multiple lines and `^`/`$` refer to the start and end of the output
+// (unless the `m` flag is set). When none of the patterns can match
+// a newline or the start/end of the output, every match is contained
+// within a single line, so the output is matched a line at a time
+// (and results emitted as they are found) instead.
+//
+// When there are multiple mappings, the earliest match of any pattern
+// is used, with ties going to the first mapping.
+type RegexpOutputParser struct {
+}
+
+// Parse parses command output, emitting each result as it is parsed.
+func (p *RegexpOutputParser) Parse(output CommandOutput, mapping ResultMapping, emit ResultEmitter) error {
+	return p.ParseMappings(output, ResultMappings{mapping}, emit)
+}
+
+// ParseMappings parses command output, trying each mapping's pattern in order.
+func (p *RegexpOutputParser) ParseMappings(
+	output CommandOutput, mappings ResultMappings, emit ResultEmitter,
+) error {
+	// Validate the regexp patterns.
+	patterns := make([]*regexp.Regexp, len(mappings))
+	multiline := false
+	for idx, mapping := range mappings {
+		if mapping.Pattern == "" {
+			return fmt.Errorf("mapping pattern is required when output format is regexp")
+		}
+		r, err := regexp.Compile(mapping.Pattern)
+		if err != nil {
+			return fmt.Errorf("mapping pattern: %w", err)
+		}
+		patterns[idx] = r
+		multiline = multiline || regexpSpansLines(mapping.Pattern)
+	}
+
+	// Emits all the matches in text.
+	process := func(text string) error {
+		matches := make([][][]int, len(patterns))
+		for idx, r := range patterns {
+			matches[idx] = r.FindAllStringSubmatchIndex(text, -1)
+		}
+
+		consumed := 0
+		for {
+			idx, loc := nextRegexpMatch(matches, consumed)
+			if loc == nil {
+				return nil
+			}
+			keys := patterns[idx].SubexpNames()
+			item := resultData{}
+			for i := 1; i < len(keys); i++ {
+				item[keys[i]] = ""
+				if loc[2*i] >= 0 {
+					item[keys[i]] = text[loc[2*i]:loc[2*i+1]]
+				}
+			}
+			if err := emitMapped(mappings[idx], item, emit); err != nil {
+				return err
+			}
+			consumed = loc[1]
+		}
+	}
+
+	if multiline {
+		buf, err := io.ReadAll(output.Content)
+		if err != nil {
+			return err
+		}
+		return process(ansiRegexp.ReplaceAllString(string(buf), ""))
+	}
+
+	scanner := newLineScanner(output.Content)
+	for scanner.Scan() {
+		if err := process(ansiRegexp.ReplaceAllString(scanner.Text(), "")); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// Returns true if pattern can match a newline, or the start or end
+// of the entire text (in which case it can't be matched line by line).
+func regexpSpansLines(pattern string) bool {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return true
+	}
+	var walk func(re *syntax.Regexp) bool
+	walk = func(re *syntax.Regexp) bool {
+		switch re.Op {
+		case syntax.OpAnyChar, syntax.OpBeginText, syntax.OpEndText:
+			return true
+		case syntax.OpLiteral:
+			if slices.Contains(re.Rune, '\n') {
+				return true
+			}
+		case syntax.OpCharClass:
+			for i := 0; i+1 < len(re.Rune); i += 2 {
+				if re.Rune[i] <= '\n' && '\n' <= re.Rune[i+1] {
+					return true
+				}
+			}
+		}
+		return slices.ContainsFunc(re.Sub, walk)
+	}
+	return walk(re)
+}
+
+// Returns the earliest non-empty match starting at or after offset,
+// along with the index of the pattern that matched.
+// Matches are consumed from the front of each pattern's list.
+func nextRegexpMatch(matches [][][]int, offset int) (int, []int) {
+	best := -1
+	for idx := range matches {
+		for len(matches[idx]) > 0 {
+			loc := matches[idx][0]
+			if loc[0] >= offset && loc[1] > loc[0] {
+				break
+			}
+			// Ignore empty matches and those overlapping a previous match.
+			matches[idx] = matches[idx][1:]
+		}
+		if len(matches[idx]) == 0 {
+			continue
+		}
+		if best == -1 || matches[idx][0][0] < matches[best][0][0] {
+			best = idx
+		}
+	}
+	if best == -1 {
+		return -1, nil
+	}
+	loc := matches[best][0]
+	matches[best] = matches[best][1:]
+	return best, loc
+}
+
+/*
+* SarifOutputParser
+**/
+
+// SarifOutputParser parses SARIF formatted output.
+// SARIF documents are parsed as a whole, so the output is buffered.
+type SarifOutputParser struct {
+}
+
+// Parse parses command output, emitting each result as it is parsed.
+func (p *SarifOutputParser) Parse(output CommandOutput, _ ResultMapping, emit ResultEmitter) error {
+	// Read the content.
+	buf, err := io.ReadAll(output.Content)
+	if err != nil {
+		return err
+	}
+	content := ansiRegexp.ReplaceAllString(string(buf), "")
+	if content == "" {
+		return nil // nothing to parse
+	}
+
+	// Parse.
+	report, err := sarif.FromString(content)
+	if err != nil {
+		return fmt.Errorf("invalid sarif: %w", err)
+	}
+
+	// Map the report to `Result` structs.
+	for _, run := range report.Runs {
+		if run == nil {
+			continue
+		}
+		sr := newSarifRun(run)
+		for _, result := range run.Results {
+			if result == nil {
+				continue
+			}
+			issue, err := sr.result(result)
 			if err != nil {
-				return resultLocation, err
+				return err
+			}
+			if err := emit(issue); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// The maximum number of uriBaseId references followed when resolving a URI.
+const sarifMaxURIBaseDepth = 10
+
+// Matches message placeholders (i.e. "{0}") and escaped braces.
+var sarifPlaceholderRegexp = regexp.MustCompile(`\{\{|\}\}|\{(\d+)\}`)
+
+// sarifRun resolves the things a SARIF result can reference
+// elsewhere in its run: rules, artifacts, and base URIs.
+type sarifRun struct {
+	run   *sarif.Run
+	rules map[*sarif.ToolComponent]map[string]*sarif.ReportingDescriptor
+}
+
+// sarifRule is the rule a SARIF result references.
+// Either field may be nil if the run does not describe it.
+type sarifRule struct {
+	component  *sarif.ToolComponent
+	descriptor *sarif.ReportingDescriptor
+}
+
+func newSarifRun(run *sarif.Run) *sarifRun {
+	return &sarifRun{
+		run:   run,
+		rules: map[*sarif.ToolComponent]map[string]*sarif.ReportingDescriptor{},
+	}
+}
+
+func (sr *sarifRun) result(result *sarif.Result) (*Result, error) {
+	rule := sr.ruleFor(result)
+
+	level, err := CoerceResultLevel(sr.levelFor(result, rule))
+	if err != nil {
+		return nil, fmt.Errorf("invalid sarif level: %w", err)
+	}
+
+	issue := &Result{
+		Level: level,
+		Rule:  sr.resultRule(result, rule),
+	}
+
+	if len(result.Locations) > 0 {
+		issue.Location, err = sr.location(result.Locations[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid sarif location: %w", err)
+		}
+	}
+	for _, loc := range result.RelatedLocations {
+		if loc == nil {
+			continue
+		}
+		related, err := sr.relatedLocation(loc, rule)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sarif related location: %w", err)
+		}
+		issue.RelatedLocations = append(issue.RelatedLocations, related)
+	}
+	issue.CodeFlows, err = sr.codeFlows(result.CodeFlows, rule)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sarif code flow: %w", err)
+	}
+	if len(result.Fixes) > 0 && result.Fixes[0] != nil {
+		// Each SARIF fix is an alternative way of resolving the result,
+		// so just use the first one.
+		issue.Fixes, err = sr.fixes(result.Fixes[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid sarif fix: %w", err)
+		}
+	}
+
+	return issue, nil
+}
+
+// Returns the rule referenced by result (via ruleIndex or ruleId).
+func (sr *sarifRun) ruleFor(result *sarif.Result) sarifRule {
+	rule := sarifRule{
+		component: sr.run.Tool.Driver,
+	}
+	index := result.RuleIndex
+	if result.Rule != nil {
+		if result.Rule.ToolComponent != nil {
+			rule.component = sr.componentFor(result.Rule.ToolComponent)
+		}
+		if index == nil {
+			index = result.Rule.Index
+		}
+	}
+	if rule.component == nil {
+		return rule
+	}
+
+	rules := rule.component.Rules
+	if index != nil && int(*index) < len(rules) {
+		rule.descriptor = rules[*index]
+		return rule
+	}
+	if id := sarifRuleID(result); id != "" {
+		rule.descriptor = sr.rulesByID(rule.component)[id]
+	}
+	return rule
+}
+
+// Returns the tool component (driver or extension) referenced by ref.
+func (sr *sarifRun) componentFor(ref *sarif.ToolComponentReference) *sarif.ToolComponent {
+	extensions := sr.run.Tool.Extensions
+	if ref.Index != nil {
+		if int(*ref.Index) < len(extensions) {
+			return extensions[*ref.Index]
+		}
+		return nil
+	}
+	if ref.Name != nil {
+		for _, ext := range extensions {
+			if ext != nil && ext.Name == *ref.Name {
+				return ext
 			}
 		}
 	}
-	reg := pl.Region
-	if reg != nil {
-		resultLocation.StartLine = *reg.StartLine
-		resultLocation.StartColumn = *reg.StartColumn
-		resultLocation.EndLine = *reg.EndLine
-		resultLocation.EndColumn = *reg.EndColumn
+	return sr.run.Tool.Driver
+}
+
+// Returns the rules of component indexed by ID.
+func (sr *sarifRun) rulesByID(component *sarif.ToolComponent) map[string]*sarif.ReportingDescriptor {
+	if rules, ok := sr.rules[component]; ok {
+		return rules
+	}
+	rules := map[string]*sarif.ReportingDescriptor{}
+	for _, descriptor := range component.Rules {
+		if descriptor != nil {
+			rules[descriptor.ID] = descriptor
+		}
+	}
+	sr.rules[component] = rules
+	return rules
+}
+
+// Returns the SARIF level of result, falling back to the rule's
+// default configuration and then the SARIF default of "warning".
+func (sr *sarifRun) levelFor(result *sarif.Result, rule sarifRule) string {
+	if level := stringValue(result.Level); level != "" {
+		return level
+	}
+	if kind := stringValue(result.Kind); kind != "" && kind != "fail" {
+		// Results that are not failures (i.e. "pass", "review") default to none.
+		return "none"
+	}
+	if rule.descriptor != nil && rule.descriptor.DefaultConfiguration != nil {
+		if level := rule.descriptor.DefaultConfiguration.Level; level != "" {
+			return level
+		}
+	}
+	return "warning"
+}
+
+func (sr *sarifRun) resultRule(result *sarif.Result, rule sarifRule) ResultRule {
+	resultRule := ResultRule{
+		ID: sarifRuleID(result),
+	}
+	if rule.descriptor != nil {
+		if resultRule.ID == "" {
+			resultRule.ID = rule.descriptor.ID
+		}
+		resultRule.Name = stringValue(rule.descriptor.Name)
+		resultRule.URI = stringValue(rule.descriptor.HelpURI)
+	}
+	if resultRule.Name == "" {
+		resultRule.Name = resultRule.ID
+	}
+
+	resultRule.Description = sr.message(&result.Message, rule)
+	if resultRule.Description == "" && rule.descriptor != nil {
+		resultRule.Description = multiformatMessageText(rule.descriptor.ShortDescription)
+	}
+	return resultRule
+}
+
+// Returns the text of msg, resolving message string references
+// and argument placeholders.
+func (sr *sarifRun) message(msg *sarif.Message, rule sarifRule) string {
+	if msg == nil {
+		return ""
+	}
+	text := stringValue(msg.Text)
+	if text == "" && msg.ID != nil {
+		if rule.descriptor != nil && rule.descriptor.MessageStrings != nil {
+			if str, ok := (*rule.descriptor.MessageStrings)[*msg.ID]; ok {
+				text = stringValue(str.Text)
+			}
+		}
+		if text == "" && rule.component != nil {
+			text = multiformatMessageText(rule.component.GlobalMessageStrings[*msg.ID])
+		}
+	}
+	if len(msg.Arguments) == 0 {
+		return text
+	}
+	return sarifPlaceholderRegexp.ReplaceAllStringFunc(text, func(match string) string {
+		switch match {
+		case "{{":
+			return "{"
+		case "}}":
+			return "}"
+		}
+		idx, _ := strconv.Atoi(match[1 : len(match)-1])
+		if idx < len(msg.Arguments) {
+			return msg.Arguments[idx]
+		}
+		return match
+	})
+}
+
+func (sr *sarifRun) location(loc *sarif.Location) (ResultLocation, error) {
+	resultLocation := ResultLocation{}
+	if loc == nil || loc.PhysicalLocation == nil {
+		return resultLocation, nil
+	}
+
+	pl := loc.PhysicalLocation
+	if pl.ArtifactLocation != nil {
+		path, err := sr.artifactPath(pl.ArtifactLocation)
+		if err != nil {
+			return resultLocation, err
+		}
+		resultLocation.Path = path
+	}
+	if reg := pl.Region; reg != nil {
+		resultLocation.StartLine = intValue(reg.StartLine)
+		resultLocation.StartColumn = intValue(reg.StartColumn)
+		resultLocation.EndLine = intValue(reg.EndLine)
+		resultLocation.EndColumn = intValue(reg.EndColumn)
 	}
 
 	return resultLocation, nil
 }
+
+func (sr *sarifRun) relatedLocation(loc *sarif.Location, rule sarifRule) (*ResultRelatedLocation, error) {
+	resultLocation, err := sr.location(loc)
+	if err != nil {
+		return nil, err
+	}
+	return &ResultRelatedLocation{
+		Location: resultLocation,
+		Message:  sr.message(loc.Message, rule),
+	}, nil
+}
+
+// Flattens code flows into one ResultCodeFlow per thread flow.
+func (sr *sarifRun) codeFlows(flows []*sarif.CodeFlow, rule sarifRule) ([]*ResultCodeFlow, error) {
+	var codeFlows []*ResultCodeFlow
+	for _, flow := range flows {
+		if flow == nil {
+			continue
+		}
+		for _, thread := range flow.ThreadFlows {
+			if thread == nil {
+				continue
+			}
+			codeFlow := &ResultCodeFlow{
+				Message:   sr.message(thread.Message, rule),
+				Locations: []*ResultRelatedLocation{},
+			}
+			if codeFlow.Message == "" {
+				codeFlow.Message = sr.message(flow.Message, rule)
+			}
+			for _, step := range thread.Locations {
+				if step == nil || step.Location == nil {
+					continue
+				}
+				loc, err := sr.relatedLocation(step.Location, rule)
+				if err != nil {
+					return nil, err
+				}
+				codeFlow.Locations = append(codeFlow.Locations, loc)
+			}
+			codeFlows = append(codeFlows, codeFlow)
+		}
+	}
+	return codeFlows, nil
+}
+
+// Returns the edits making up fix, or nil if any of them can't be applied
+// (i.e. regions described only by character offsets).
+func (sr *sarifRun) fixes(fix *sarif.Fix) ([]*ResultFix, error) {
+	kind := sr.columnKind()
+	fixes := []*ResultFix{}
+	for _, change := range fix.ArtifactChanges {
+		if change == nil {
+			continue
+		}
+		path, err := sr.artifactPath(&change.ArtifactLocation)
+		if err != nil {
+			return nil, err
+		}
+		for _, replacement := range change.Replacements {
+			if replacement == nil {
+				continue
+			}
+			reg := replacement.DeletedRegion
+			if reg.StartLine == nil && reg.ByteOffset == nil {
+				// Applying the rest of the fix without this edit
+				// would leave the file half fixed.
+				return nil, nil
+			}
+			resultFix := &ResultFix{
+				Location: ResultLocation{
+					Path:        path,
+					StartLine:   intValue(reg.StartLine),
+					StartColumn: intValue(reg.StartColumn),
+					EndLine:     intValue(reg.EndLine),
+					EndColumn:   intValue(reg.EndColumn),
+				},
+				ByteOffset: intValue(reg.ByteOffset),
+				ByteLength: intValue(reg.ByteLength),
+				columnKind: kind,
+			}
+			if replacement.InsertedContent != nil && replacement.InsertedContent.Text != nil {
+				resultFix.Replacement = *replacement.InsertedContent.Text
+			}
+			fixes = append(fixes, resultFix)
+		}
+	}
+
+	return fixes, nil
+}
+
+// Returns the unit columns are measured in for the run.
+func (sr *sarifRun) columnKind() columnKind {
+	if kind, ok := sr.run.ColumnKind.(string); ok && kind == string(columnKindUnicodeCodePoints) {
+		return columnKindUnicodeCodePoints
+	}
+	// This is the SARIF default.
+	return columnKindUTF16CodeUnits
+}
+
+// Returns the path of al relative to the current working dir.
+func (sr *sarifRun) artifactPath(al *sarif.ArtifactLocation) (string, error) {
+	uri, err := sr.artifactURI(al, 0)
+	if err != nil || uri == "" {
+		return "", err
+	}
+	return fsutils.RelativePath(uri)
+}
+
+// Returns the URI of al, following artifact indexes and uriBaseIds.
+func (sr *sarifRun) artifactURI(al *sarif.ArtifactLocation, depth int) (string, error) {
+	if depth > sarifMaxURIBaseDepth {
+		return "", errors.New("too many nested uriBaseIds")
+	}
+
+	uri := stringValue(al.URI)
+	if uri == "" && al.Index != nil && int(*al.Index) < len(sr.run.Artifacts) {
+		artifact := sr.run.Artifacts[*al.Index]
+		if artifact != nil && artifact.Location != nil {
+			return sr.artifactURI(artifact.Location, depth+1)
+		}
+	}
+	if uri == "" || al.URIBaseId == nil {
+		return uri, nil
+	}
+
+	base, ok := sr.run.OriginalUriBaseIDs[*al.URIBaseId]
+	if !ok || base == nil {
+		// Undefined base IDs (i.e. "%SRCROOT%") are typically
+		// the root of the analysis, which is the working dir.
+		return uri, nil
+	}
+	baseURI, err := sr.artifactURI(base, depth+1)
+	if err != nil || baseURI == "" {
+		return uri, err
+	}
+	if !strings.HasSuffix(baseURI, "/") {
+		baseURI += "/"
+	}
+	parsedBase, err := url.Parse(baseURI)
+	if err != nil {
+		return "", fmt.Errorf("invalid uriBaseId %s: %w", *al.URIBaseId, err)
+	}
+	parsedURI, err := url.Parse(uri)
+	if err != nil {
+		return "", fmt.Errorf("invalid uri: %w", err)
+	}
+	if !parsedBase.IsAbs() && !path.IsAbs(parsedBase.Path) && !parsedURI.IsAbs() {
+		// Relative base URIs aren't valid SARIF, but some tools emit them.
+		return path.Join(baseURI, uri), nil
+	}
+	return parsedBase.ResolveReference(parsedURI).String(), nil
+}
+
+// Returns the ID of the rule referenced by result.
+func sarifRuleID(result *sarif.Result) string {
+	if id := stringValue(result.RuleID); id != "" {
+		return id
+	}
+	if result.Rule != nil {
+		return stringValue(result.Rule.Id)
+	}
+	return ""
+}
+
+// Returns the plain text of msg, or "" if nil.
+func multiformatMessageText(msg *sarif.MultiformatMessageString) string {
+	if msg == nil {
+		return ""
+	}
+	return stringValue(msg.Text)
+}
+
+/*
+* TOMLOutputParser
+**/
+
+// TOMLOutputParser parses TOML formatted output.
+//
+// The output is decoded into the same structure as JSON output,
+// so the mapping pattern is a GJSON path (i.e. "issues" for [[issues]]).
+// TOML documents are parsed as a whole, so the output is buffered.
+type TOMLOutputParser struct {
+}
+
+// Parse parses command output, emitting each result as it is parsed.
+func (p *TOMLOutputParser) Parse(output CommandOutput, mapping ResultMapping, emit ResultEmitter) error {
+	buf, err := io.ReadAll(newANSIStripReader(output.Content))
+	if err != nil {
+		return err
+	}
+	if len(bytes.TrimSpace(buf)) == 0 {
+		return nil // nothing to parse
+	}
+
+	doc := map[string]any{}
+	if err := toml.Unmarshal(buf, &doc); err != nil {
+		return fmt.Errorf("invalid toml: %w", err)
+	}
+	return emitDecoded(doc, mapping, emit)
+}
+
+/*
+* XMLOutputParser
+**/
+
+// XMLOutputParser parses XML formatted output.
+//
+// The mapping pattern is an XPath expression that selects result elements.
+// Each element is mapped using its attributes, its trimmed text (as "text"),
+// its child elements (by name, using the first of each), and the attributes
+// of its ancestors (as "parent", "parent.parent", etc).
+// Attributes take precedence when names collide.
+// XPath expressions may reference the entire document,
+// so the output is buffered before being parsed.
+type XMLOutputParser struct {
+}
+
+// Parse parses command output, emitting each result as it is parsed.
+func (p *XMLOutputParser) Parse(output CommandOutput, mapping ResultMapping, emit ResultEmitter) error {
+	if mapping.Pattern == "" {
+		return fmt.Errorf("mapping pattern is required when output format is xml")
+	}
+	expr, err := xpath.Compile(mapping.Pattern)
+	if err != nil {
+		return fmt.Errorf("invalid xpath: %w", err)
+	}
+
+	buf, err := io.ReadAll(newANSIStripReader(output.Content))
+	if err != nil {
+		return err
+	}
+	if len(bytes.TrimSpace(buf)) == 0 {
+		return nil // nothing to parse
+	}
+
+	doc, err := xmlquery.Parse(bytes.NewReader(buf))
+	if err != nil {
+		return fmt.Errorf("invalid xml: %w", err)
+	}
+
+	for _, node := range xmlquery.QuerySelectorAll(doc, expr) {
+		if node.Type != xmlquery.ElementNode {
+			return fmt.Errorf(
+				"invalid output: pattern=%v must select elements", mapping.Pattern,
+			)
+		}
+		if err := emitMapped(mapping, xmlElementData(node), emit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns the data for a selected element, including its ancestors.
+func xmlElementData(node *xmlquery.Node) resultData {
+	data := xmlSubtreeData(node)
+	if parent := xmlAncestorData(node.Parent); parent != nil {
+		setMissing(data, "parent", parent)
+	}
+	return data
+}
+
+// Returns the attributes, text, and child elements of node.
+func xmlSubtreeData(node *xmlquery.Node) resultData {
+	data := xmlAttrData(node)
+	setMissing(data, "text", strings.TrimSpace(node.InnerText()))
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type == xmlquery.ElementNode {
+			setMissing(data, child.Data, xmlSubtreeData(child))
+		}
+	}
+	return data
+}
+
+// Returns the attributes of node and its ancestors.
+// Ancestor text and children are omitted since they would include
+// every sibling result (i.e. checkstyle file elements).
+func xmlAncestorData(node *xmlquery.Node) resultData {
+	if node == nil || node.Type != xmlquery.ElementNode {
+		return nil
+	}
+	data := xmlAttrData(node)
+	if parent := xmlAncestorData(node.Parent); parent != nil {
+		setMissing(data, "parent", parent)
+	}
+	return data
+}
+
+func xmlAttrData(node *xmlquery.Node) resultData {
+	data := resultData{}
+	for _, attr := range node.Attr {
+		setMissing(data, attr.Name.Local, attr.Value)
+	}
+	return data
+}
+
+// Sets data[key] to value unless the key is already present.
+func setMissing(data resultData, key string, value any) {
+	if _, ok := data[key]; !ok {
+		data[key] = value
+	}
+}
+
+/*
+* YAMLOutputParser
+**/
+
+// YAMLOutputParser parses YAML formatted output.
+//
+// The output is decoded into the same structure as JSON output,
+// so the mapping pattern is a GJSON path (defaulting to "@this").
+// Output containing multiple documents is treated as an array of them.
+// YAML documents are parsed as a whole, so the output is buffered.
+type YAMLOutputParser struct {
+}
+
+// Parse parses command output, emitting each result as it is parsed.
+func (p *YAMLOutputParser) Parse(output CommandOutput, mapping ResultMapping, emit ResultEmitter) error {
+	buf, err := io.ReadAll(newANSIStripReader(output.Content))
+	if err != nil {
+		return err
+	}
+
+	docs := []any{}
+	decoder := yaml.NewDecoder(bytes.NewReader(buf))
+	for {
+		var doc any
+		err := decoder.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("invalid yaml: %w", err)
+		}
+		if doc != nil {
+			docs = append(docs, doc)
+		}
+	}
+
+	switch len(docs) {
+	case 0:
+		return nil // nothing to parse
+	case 1:
+		return emitDecoded(docs[0], mapping, emit)
+	default:
+		return emitDecoded(docs, mapping, emit)
+	}
+}
+
+// Evaluates the mapping pattern against a decoded document
+// by converting it to JSON (so YAML and TOML share the JSON semantics).
+func emitDecoded(doc any, mapping ResultMapping, emit ResultEmitter) error {
+	buf, err := json.Marshal(jsonCompatible(doc))
+	if err != nil {
+		return fmt.Errorf("invalid output: %w", err)
+	}
+	pattern := "@this"
+	if mapping.Pattern != "" {
+		pattern = mapping.Pattern
+	}
+	return emitGJSON(string(buf), pattern, mapping, emit)
+}
+
+// Converts maps w/ non-string keys (which YAML allows) to string keyed maps.
+func jsonCompatible(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = jsonCompatible(item)
+		}
+		return v
+	case map[any]any:
+		converted := make(map[string]any, len(v))
+		for key, item := range v {
+			converted[fmt.Sprint(key)] = jsonCompatible(item)
+		}
+		return converted
+	case []any:
+		for idx, item := range v {
+			v[idx] = jsonCompatible(item)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// Returns the value of p, or 0 if nil.
+func intValue(p *int) int {
+	if p == nil {
+		return 0
+	}
+	return *p
+}
+
+// Returns the value of p, or "" if nil.
+func stringValue(p *string) string {
+	if p == nil {
+		return ""
+	}
+	return *p
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"testing"
 	"testing/iotest"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -34,6 +35,292 @@ func TestNewOutputParser(t *testing.T) {
 	})
 }
 
+func TestParseMappings(t *testing.T) {
+	path := render.MustCompile(`{{ .file }}`)
+	desc := render.MustCompile(`{{ .msg }}`)
+
+	tests := []struct {
+		desc     string
+		parser   OutputParser
+		mappings ResultMappings
+		content  string
+		expected []*Result
+		err      string
+	}{
+		{
+			desc:     "uses an empty mapping when there are none",
+			parser:   &JSONOutputParser{},
+			mappings: nil,
+			content:  `[{"file": "a.txt"}]`,
+			expected: []*Result{{}},
+		},
+		{
+			desc:   "regexp: uses the earliest match of any pattern",
+			parser: &RegexpOutputParser{},
+			mappings: ResultMappings{
+				{
+					Pattern:         `(?m)^E (?P<file>\S+): (?P<msg>.*)$`,
+					Level:           render.MustCompile(`error`),
+					Path:            path,
+					RuleDescription: desc,
+				},
+				{
+					Pattern:         `(?m)^W (?P<file>\S+): (?P<msg>.*)$`,
+					Level:           render.MustCompile(`warning`),
+					Path:            path,
+					RuleDescription: desc,
+				},
+				{
+					Pattern:         `(?m)^(?P<file>\S+) could not be parsed$`,
+					Level:           render.MustCompile(`error`),
+					Path:            path,
+					RuleDescription: render.MustCompile(`Parse error`),
+				},
+			},
+			content: "W a.txt: one\nE b.txt: two\nc.txt could not be parsed\nW d.txt: three\n",
+			expected: []*Result{
+				{
+					Level:    ResultLevelWarning,
+					Location: ResultLocation{Path: "a.txt"},
+					Rule:     ResultRule{Description: "one"},
+				},
+				{
+					Level:    ResultLevelError,
+					Location: ResultLocation{Path: "b.txt"},
+					Rule:     ResultRule{Description: "two"},
+				},
+				{
+					Level:    ResultLevelError,
+					Location: ResultLocation{Path: "c.txt"},
+					Rule:     ResultRule{Description: "Parse error"},
+				},
+				{
+					Level:    ResultLevelWarning,
+					Location: ResultLocation{Path: "d.txt"},
+					Rule:     ResultRule{Description: "three"},
+				},
+			},
+		},
+		{
+			desc:   "regexp: ties go to the first mapping",
+			parser: &RegexpOutputParser{},
+			mappings: ResultMappings{
+				{Pattern: `(?P<file>\S+): (?P<msg>.*)`, Path: path, RuleDescription: desc},
+				{Pattern: `(?P<file>\S+)`, Path: path},
+			},
+			content: "a.txt: one\n",
+			expected: []*Result{
+				{Location: ResultLocation{Path: "a.txt"}, Rule: ResultRule{Description: "one"}},
+			},
+		},
+		{
+			desc:   "regexp: returns an error when any pattern is missing",
+			parser: &RegexpOutputParser{},
+			mappings: ResultMappings{
+				{Pattern: `(?P<file>\S+)`},
+				{},
+			},
+			content: "a.txt\n",
+			err:     "mapping pattern is required",
+		},
+		{
+			desc:   "jsonl: uses the first mapping whose pattern exists",
+			parser: &JSONLOutputParser{},
+			mappings: ResultMappings{
+				{Pattern: "error", Level: render.MustCompile(`error`), Path: path},
+				{Pattern: "warning", Level: render.MustCompile(`warning`), Path: path},
+			},
+			content: `{"warning": {"file": "a.txt"}}` + "\n" +
+				`{"error": {"file": "b.txt"}, "warning": {"file": "b.txt"}}` + "\n",
+			expected: []*Result{
+				{Level: ResultLevelWarning, Location: ResultLocation{Path: "a.txt"}},
+				{Level: ResultLevelError, Location: ResultLocation{Path: "b.txt"}},
+			},
+		},
+		{
+			desc:   "json: parses the output once per mapping",
+			parser: &JSONOutputParser{},
+			mappings: ResultMappings{
+				{Pattern: "errors", Level: render.MustCompile(`error`), Path: path},
+				{Pattern: "warnings", Level: render.MustCompile(`warning`), Path: path},
+			},
+			content: `{"warnings": [{"file": "a.txt"}], "errors": [{"file": "b.txt"}]}`,
+			expected: []*Result{
+				{Level: ResultLevelError, Location: ResultLocation{Path: "b.txt"}},
+				{Level: ResultLevelWarning, Location: ResultLocation{Path: "a.txt"}},
+			},
+		},
+		{
+			desc:   "rdjsonl: parses the output once when mappings are ignored",
+			parser: &RDJSONLOutputParser{},
+			mappings: ResultMappings{
+				{Pattern: "one"},
+				{Pattern: "two"},
+			},
+			content: `{"message": "one", "location": {"path": "a.txt"}}` + "\n",
+			expected: []*Result{
+				{Location: ResultLocation{Path: "a.txt"}, Rule: ResultRule{Description: "one"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var actual []*Result
+			err := ParseMappings(tt.parser, CommandOutput{
+				Content: strings.NewReader(tt.content),
+			}, tt.mappings, func(r *Result) error {
+				actual = append(actual, r)
+				return nil
+			})
+
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestParseMappings_Diff(t *testing.T) {
+	content := "--- a.txt\n+++ a.txt\n@@ -1 +1 @@\n-one\n+two\n@@ -3 +3 @@\n-three\n+four\n"
+
+	// Only the first mapping is used, otherwise each hunk would be
+	// reported again for the second mapping.
+	var actual []*Result
+	err := ParseMappings(&DiffOutputParser{}, CommandOutput{
+		Content: strings.NewReader(content),
+	}, ResultMappings{
+		{Pattern: diffPatternFile},
+		{Pattern: diffPatternHunk},
+	}, func(r *Result) error {
+		actual = append(actual, r)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Len(t, actual, 1)
+	assert.Equal(t, "a.txt", actual[0].Location.Path)
+}
+
+func TestParseMappings_ReadsOutputOnce(t *testing.T) {
+	content := &countingReader{r: strings.NewReader(`{"errors": [{"file": "a.txt"}], "warnings": [{"file": "b.txt"}]}`)}
+
+	var actual []*Result
+	err := ParseMappings(&JSONOutputParser{}, CommandOutput{
+		Content: content,
+	}, ResultMappings{
+		{Pattern: "errors", Path: render.MustCompile(`{{ .file }}`)},
+		{Pattern: "warnings", Path: render.MustCompile(`{{ .file }}`)},
+	}, func(r *Result) error {
+		actual = append(actual, r)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Len(t, actual, 2)
+	// Read until EOF a single time, not once per mapping.
+	assert.Equal(t, 1, content.eofs)
+}
+
+// countingReader counts the number of times r returns io.EOF.
+type countingReader struct {
+	r    io.Reader
+	eofs int
+}
+
+func (cr *countingReader) Read(p []byte) (int, error) {
+	n, err := cr.r.Read(p)
+	if errors.Is(err, io.EOF) {
+		cr.eofs++
+	}
+	return n, err
+}
+
+func TestParseOutput(t *testing.T) {
+	path := render.MustCompile(`{{ .file }}`)
+
+	tests := []struct {
+		desc    string
+		parser  OutputParser
+		mapping ResultMapping
+		first   string
+		rest    string
+	}{
+		{
+			desc:    "errorformat",
+			parser:  &ErrorformatOutputParser{},
+			mapping: ResultMapping{Pattern: `%f:%l`},
+			first:   "a.txt:1\n",
+			rest:    "a.txt:2\n",
+		},
+		{
+			desc:    "json",
+			parser:  &JSONOutputParser{},
+			mapping: ResultMapping{Path: path},
+			first:   `[{"file": "a.txt"},`,
+			rest:    `{"file": "a.txt"}]`,
+		},
+		{
+			desc:    "jsonl",
+			parser:  &JSONLOutputParser{},
+			mapping: ResultMapping{Path: path},
+			first:   `{"file": "a.txt"}` + "\n",
+			rest:    `{"file": "a.txt"}` + "\n",
+		},
+		{
+			desc:    "regexp",
+			parser:  &RegexpOutputParser{},
+			mapping: ResultMapping{Pattern: `(?P<file>.*):(?P<line>\d+)`, Path: path},
+			first:   "a.txt:1\n",
+			rest:    "a.txt:2\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc+" emits results before the output is complete", func(t *testing.T) {
+			reader, writer := io.Pipe()
+			emitted := make(chan *Result, 2)
+			done := make(chan error, 1)
+			go func() {
+				done <- tt.parser.Parse(CommandOutput{Content: reader}, tt.mapping, func(r *Result) error {
+					emitted <- r
+					return nil
+				})
+			}()
+
+			_, err := writer.Write([]byte(tt.first))
+			require.NoError(t, err)
+			// The first result arrives while the writer is still open.
+			select {
+			case r := <-emitted:
+				assert.Equal(t, "a.txt", r.Location.Path)
+			case <-time.After(5 * time.Second):
+				require.Fail(t, "timed out waiting for the first result")
+			}
+
+			_, err = writer.Write([]byte(tt.rest))
+			require.NoError(t, err)
+			require.NoError(t, writer.Close())
+			require.NoError(t, <-done)
+			assert.Equal(t, "a.txt", (<-emitted).Location.Path)
+		})
+	}
+
+	t.Run("stops at the first emit error", func(t *testing.T) {
+		count := 0
+		err := (&RegexpOutputParser{}).Parse(
+			CommandOutput{Content: bytes.NewBufferString("a.txt:1\nb.txt:2\n")},
+			tests[3].mapping,
+			func(r *Result) error {
+				count++
+				return errors.New("boom")
+			},
+		)
+		assert.ErrorContains(t, err, "boom")
+		assert.Equal(t, 1, count)
+	})
+}
+
 func TestCheckstyleOutputParser_Parse(t *testing.T) {
 	tests := []struct {
 		desc     string
@@ -108,7 +395,7 @@ func TestCheckstyleOutputParser_Parse(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.desc, func(t *testing.T) {
-			actual, err := (&CheckstyleOutputParser{}).Parse(
+			actual, err := ParseOutput(&CheckstyleOutputParser{},
 				CommandOutput{
 					Content: tt.content,
 				},
@@ -130,6 +417,7 @@ func TestDiffOutputParser_Parse(t *testing.T) {
 	tests := []struct {
 		desc     string
 		content  io.Reader
+		pattern  string
 		expected []*Result
 		err      string
 	}{
@@ -142,7 +430,7 @@ func TestDiffOutputParser_Parse(t *testing.T) {
 		{
 			desc:     "returns an empty slice when not a diff",
 			content:  bytes.NewBufferString("not a diff"),
-			expected: []*Result{},
+			expected: nil,
 			err:      "",
 		},
 		{
@@ -151,6 +439,114 @@ func TestDiffOutputParser_Parse(t *testing.T) {
 			expected: nil,
 			err:      "boom",
 		},
+		{
+			desc:     "returns an error when the pattern is invalid",
+			content:  mustOpenFile("testdata/output/shfmt.diff"),
+			pattern:  "line",
+			expected: nil,
+			err:      "invalid diff pattern",
+		},
+		{
+			desc: "emits a result per hunk",
+			content: bytes.NewBufferString(
+				"--- main.go.orig\n" +
+					"+++ main.go\n" +
+					"@@ -2,3 +2,3 @@\n" +
+					" package main\n" +
+					"-import   \"fmt\"\n" +
+					"+import \"fmt\"\n" +
+					" \n" +
+					"@@ -40,2 +40,3 @@\n" +
+					" func main() {\n" +
+					"+\tfmt.Println()\n" +
+					" }\n" +
+					"@@ -50,0 +52,1 @@\n" +
+					"+// EOF\n",
+			),
+			expected: []*Result{
+				newDiffResult("main.go", 3, 3, []string{
+					"@@ -2,3 +2,3 @@",
+					" package main",
+					"-import   \"fmt\"",
+					"+import \"fmt\"",
+					" ",
+				}, []*ResultFix{
+					{
+						Location: ResultLocation{
+							StartLine: 2, StartColumn: 1, EndLine: 5, EndColumn: 1,
+						},
+						Replacement: "package main\nimport \"fmt\"\n\n",
+					},
+				}),
+				newDiffResult("main.go", 41, 41, []string{
+					"@@ -40,2 +40,3 @@",
+					" func main() {",
+					"+\tfmt.Println()",
+					" }",
+				}, []*ResultFix{
+					{
+						Location: ResultLocation{
+							StartLine: 40, StartColumn: 1, EndLine: 42, EndColumn: 1,
+						},
+						Replacement: "func main() {\n\tfmt.Println()\n}\n",
+					},
+				}),
+				newDiffResult("main.go", 51, 51, []string{
+					"@@ -50,0 +52,1 @@",
+					"+// EOF",
+				}, []*ResultFix{
+					{
+						Location: ResultLocation{
+							StartLine: 51, StartColumn: 1, EndLine: 51, EndColumn: 1,
+						},
+						Replacement: "// EOF\n",
+					},
+				}),
+			},
+			err: "",
+		},
+		{
+			desc: "emits a result per file when pattern is file",
+			content: bytes.NewBufferString(
+				"--- main.go.orig\n" +
+					"+++ main.go\n" +
+					"@@ -2,2 +2,2 @@\n" +
+					" package main\n" +
+					"-import   \"fmt\"\n" +
+					"+import \"fmt\"\n" +
+					"@@ -40,2 +40,3 @@\n" +
+					" func main() {\n" +
+					"+\tfmt.Println()\n" +
+					" }\n",
+			),
+			pattern: "file",
+			expected: []*Result{
+				newDiffResult("main.go", 3, 0, []string{
+					"@@ -2,2 +2,2 @@",
+					" package main",
+					"-import   \"fmt\"",
+					"+import \"fmt\"",
+					"@@ -40,2 +40,3 @@",
+					" func main() {",
+					"+\tfmt.Println()",
+					" }",
+				}, []*ResultFix{
+					{
+						Location: ResultLocation{
+							StartLine: 2, StartColumn: 1, EndLine: 4, EndColumn: 1,
+						},
+						Replacement: "package main\nimport \"fmt\"\n",
+					},
+					{
+						Location: ResultLocation{
+							StartLine: 40, StartColumn: 1, EndLine: 42, EndColumn: 1,
+						},
+						Replacement: "func main() {\n\tfmt.Println()\n}\n",
+					},
+				}),
+			},
+			err: "",
+		},
 		{
 			desc:    "parses diffs",
 			content: mustOpenFile("testdata/output/shfmt.diff"),
@@ -160,6 +556,7 @@ func TestDiffOutputParser_Parse(t *testing.T) {
 					Location: ResultLocation{
 						Path:      "bin/command.sh",
 						StartLine: 4,
+						EndLine:   7,
 					},
 					Rule: ResultRule{
 						ID:          "diff",
@@ -183,12 +580,33 @@ func TestDiffOutputParser_Parse(t *testing.T) {
 						" fi",
 					},
 					ContextLang: "diff",
+					Fixes: []*ResultFix{
+						{
+							Location: ResultLocation{
+								StartLine:   1,
+								StartColumn: 1,
+								EndLine:     11,
+								EndColumn:   1,
+							},
+							Replacement: "#!/usr/bin/env bash\n" +
+								"set -o errexit -o errtrace -o nounset -o pipefail\n" +
+								"\n" +
+								"if\n" +
+								"    [\n" +
+								"    $foo == \"bar\"\n" +
+								"    ]\n" +
+								"then\n" +
+								"    echo \"lol\"\n" +
+								"fi\n",
+						},
+					},
 				},
 				{
 					Level: ResultLevelError,
 					Location: ResultLocation{
 						Path:      "bin/entrypoint.sh",
 						StartLine: 19,
+						EndLine:   20,
 					},
 					Rule: ResultRule{
 						ID:          "diff",
@@ -208,6 +626,23 @@ func TestDiffOutputParser_Parse(t *testing.T) {
 						"",
 					},
 					ContextLang: "diff",
+					Fixes: []*ResultFix{
+						{
+							Location: ResultLocation{
+								StartLine:   16,
+								StartColumn: 1,
+								EndLine:     24,
+								EndColumn:   1,
+							},
+							Replacement: "    # fix permissions\n" +
+								"    sudo chown -R app:app \\\n" +
+								"        /app \\\n" +
+								"        /home/app \\\n" +
+								"        /run/host-services/ssh-auth.sock\n" +
+								"fi\n" +
+								"\n",
+						},
+					},
 				},
 			},
 			err: "",
@@ -215,11 +650,13 @@ func TestDiffOutputParser_Parse(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.desc, func(t *testing.T) {
-			actual, err := (&DiffOutputParser{}).Parse(
+			actual, err := ParseOutput(&DiffOutputParser{},
 				CommandOutput{
 					Content: tt.content,
 				},
-				ResultMapping{},
+				ResultMapping{
+					Pattern: tt.pattern,
+				},
 			)
 
 			if tt.err == "" {
@@ -233,93 +670,16 @@ func TestDiffOutputParser_Parse(t *testing.T) {
 	}
 }
 
-func TestJSONOutputParser_Parse(t *testing.T) {
-	file, err := os.Open("testdata/output/shellcheck.json")
-	assert.NoError(t, err)
-
-	parser := &JSONOutputParser{}
-
-	results, err := parser.Parse(
-		CommandOutput{
-			Content: bytes.NewBufferString(""),
-		},
-		ResultMapping{},
-	)
-	require.NoError(t, err)
-	require.Nil(t, results)
-
-	results, err = parser.Parse(
-		CommandOutput{
-			Content: bytes.NewBufferString("{{}"),
-		},
-		ResultMapping{},
-	)
-	require.ErrorContains(t, err, "invalid json")
-	require.Nil(t, results)
-
-	results, err = parser.Parse(
-		CommandOutput{
-			Content: iotest.ErrReader(errors.New("boom")),
-		},
-		ResultMapping{},
-	)
-	require.ErrorContains(t, err, "boom")
-	require.Nil(t, results)
-
-	results, err = parser.Parse(
-		CommandOutput{
-			Content: file,
-		},
-		ResultMapping{
-			Level:           render.MustCompile(`{{ .level }}`),
-			Path:            render.MustCompile(`{{ .file }}`),
-			StartLine:       render.MustCompile(`{{ .line }}`),
-			StartColumn:     render.MustCompile(`{{ .column }}`),
-			EndLine:         render.MustCompile(`{{ .endLine }}`),
-			EndColumn:       render.MustCompile(`{{ .endColumn }}`),
-			RuleID:          render.MustCompile(`SC{{ .code }}`),
-			RuleName:        render.MustCompile(`SC{{ .code }}`),
-			RuleDescription: render.MustCompile(`{{ .message }}`),
-			RuleURI:         render.MustCompile(`https://www.shellcheck.net/wiki/SC{{ .code }}`),
-		},
-	)
-
-	assert.NoError(t, err)
-	require.Equal(t, 1, len(results))
-	assert.Equal(t, &Result{
-		Level: ResultLevelInfo,
-		Location: ResultLocation{
-			Path:        "entrypoint.sh",
-			StartLine:   15,
-			StartColumn: 6,
-			EndLine:     15,
-			EndColumn:   19,
-		},
-		Rule: ResultRule{
-			ID:          "SC2086",
-			Name:        "SC2086",
-			Description: "Double quote to prevent globbing and word splitting.",
-			URI:         "https://www.shellcheck.net/wiki/SC2086",
-		},
-	}, results[0])
-}
-
-func TestRegexpOutputParser_Parse(t *testing.T) {
+func TestErrorformatOutputParser_Parse(t *testing.T) {
 	mapping := ResultMapping{
 		Pattern: strings.Join([]string{
-			`(?m)`,
-			`Secret:\s+(?P<secret>.*)`,
-			`RuleID:\s+(?P<rule_id>.*)`,
-			`Entropy:\s+(?P<entropy>.*)`,
-			`File:\s+(?P<file>.*)`,
-			`Line:\s+(?P<line>.*)`,
-		}, `\n`),
-		Level:           render.MustCompile(`error`),
-		Path:            render.MustCompile(`{{ .file }}`),
-		StartLine:       render.MustCompile(`{{ .line }}`),
-		RuleID:          render.MustCompile(`{{ .rule_id }}`),
-		RuleName:        render.MustCompile(`{{ .rule_id }}`),
-		RuleDescription: render.MustCompile(`Secret detected: {{ .secret }}`),
+			`%f:%l:%c: %t%*[^:]: %m`,
+			`%EError %n`,
+			`%C  in %f`,
+			`%Cline %l`,
+			`%Ccolumn %c`,
+			`%Z%m`,
+		}, "\n"),
 	}
 
 	tests := []struct {
@@ -338,21 +698,21 @@ func TestRegexpOutputParser_Parse(t *testing.T) {
 		},
 		{
 			desc:    "returns an error if pattern is missing",
-			content: mustOpenFile("testdata/output/gitleaks.txt"),
+			content: mustOpenFile("testdata/output/errorformat.txt"),
 			mapping: ResultMapping{
-				Pattern: "",
+				Pattern: " \n ",
 			},
 			expected: nil,
 			err:      "pattern is required",
 		},
 		{
 			desc:    "returns an error if pattern is malformed",
-			content: mustOpenFile("testdata/output/gitleaks.txt"),
+			content: mustOpenFile("testdata/output/errorformat.txt"),
 			mapping: ResultMapping{
-				Pattern: "(?lol)",
+				Pattern: "%f:%l:%",
 			},
 			expected: nil,
-			err:      "error parsing regexp",
+			err:      "mapping pattern",
 		},
 		{
 			desc:     "returns an empty slice when no content",
@@ -369,44 +729,70 @@ func TestRegexpOutputParser_Parse(t *testing.T) {
 			err:      "",
 		},
 		{
-			desc:    "parses text using a regexp pattern",
-			content: mustOpenFile("testdata/output/gitleaks.txt"),
+			desc:    "parses single and multi-line errorformats",
+			content: mustOpenFile("testdata/output/errorformat.txt"),
 			mapping: mapping,
 			expected: []*Result{
 				{
-					Level: ResultLevelError,
+					Level: ResultLevelWarning,
 					Location: ResultLocation{
-						Path:      ".env",
-						StartLine: 8,
+						Path:        "main.go",
+						StartLine:   8,
+						StartColumn: 16,
 					},
 					Rule: ResultRule{
-						ID:          "github-oauth",
-						Name:        "github-oauth",
-						Description: "Secret detected: REDACTED",
+						Description: "unused variable 'x'",
 					},
 				},
 				{
 					Level: ResultLevelError,
 					Location: ResultLocation{
-						Path:      "bin/run-in-container.sh",
-						StartLine: 22,
+						Path:        "internal/foo.go",
+						StartLine:   3,
+						StartColumn: 1,
 					},
 					Rule: ResultRule{
-						ID:          "github-oauth",
-						Name:        "github-oauth",
-						Description: "Secret detected: REDACTED",
+						Description: "missing doc comment",
 					},
 				},
 				{
 					Level: ResultLevelError,
 					Location: ResultLocation{
-						Path:      "etc/githooks/commit-msg",
-						StartLine: 10,
+						Path:        "cmd/app.go",
+						StartLine:   42,
+						StartColumn: 3,
 					},
 					Rule: ResultRule{
-						ID:          "github-oauth",
-						Name:        "github-oauth",
-						Description: "Secret detected: REDACTED",
+						ID:          "275",
+						Name:        "275",
+						Description: "' ' expected after '--'",
+					},
+				},
+			},
+			err: "",
+		},
+		{
+			desc:    "uses mapping templates when defined",
+			content: bytes.NewBufferString("main.go:8:16: warning: unused variable 'x'\n"),
+			mapping: ResultMapping{
+				Pattern:  `%f:%l:%c: %t%*[^:]: %m`,
+				Level:    render.MustCompile(`info`),
+				Path:     render.MustCompile(`src/{{ .file }}`),
+				RuleID:   render.MustCompile(`{{ .type }}`),
+				RuleName: render.MustCompile(`unused`),
+			},
+			expected: []*Result{
+				{
+					Level: ResultLevelInfo,
+					Location: ResultLocation{
+						Path:        "src/main.go",
+						StartLine:   8,
+						StartColumn: 16,
+					},
+					Rule: ResultRule{
+						ID:          "w",
+						Name:        "unused",
+						Description: "unused variable 'x'",
 					},
 				},
 			},
@@ -415,7 +801,7 @@ func TestRegexpOutputParser_Parse(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.desc, func(t *testing.T) {
-			actual, err := (&RegexpOutputParser{}).Parse(
+			actual, err := ParseOutput(&ErrorformatOutputParser{},
 				CommandOutput{
 					Content: tt.content,
 				},
@@ -433,17 +819,254 @@ func TestRegexpOutputParser_Parse(t *testing.T) {
 	}
 }
 
-func TestSarifOutputParser_Parse(t *testing.T) {
-	tests := []struct {
-		desc     string
-		content  io.Reader
-		expected []*Result
-		err      string
-	}{
-		{
-			desc:     "returns an empty slice when no content",
-			content:  bytes.NewBufferString(""),
-			expected: nil,
+func TestJSONOutputParser_Parse(t *testing.T) {
+	file, err := os.Open("testdata/output/shellcheck.json")
+	assert.NoError(t, err)
+
+	parser := &JSONOutputParser{}
+
+	results, err := ParseOutput(parser,
+		CommandOutput{
+			Content: bytes.NewBufferString(""),
+		},
+		ResultMapping{},
+	)
+	require.NoError(t, err)
+	require.Nil(t, results)
+
+	results, err = ParseOutput(parser,
+		CommandOutput{
+			Content: bytes.NewBufferString("{{}"),
+		},
+		ResultMapping{},
+	)
+	require.ErrorContains(t, err, "invalid json")
+	require.Nil(t, results)
+
+	results, err = ParseOutput(parser,
+		CommandOutput{
+			Content: bytes.NewBufferString(`[{"file": "a.txt"}, "foo"]`),
+		},
+		ResultMapping{},
+	)
+	require.ErrorContains(t, err, "pattern=@this.1 is not an object")
+	require.Nil(t, results)
+
+	results, err = ParseOutput(parser,
+		CommandOutput{
+			Content: bytes.NewBufferString(`[{"file": "a.txt"}`),
+		},
+		ResultMapping{},
+	)
+	require.ErrorContains(t, err, "invalid json")
+	require.Nil(t, results)
+
+	results, err = ParseOutput(parser,
+		CommandOutput{
+			Content: iotest.ErrReader(errors.New("boom")),
+		},
+		ResultMapping{},
+	)
+	require.ErrorContains(t, err, "boom")
+	require.Nil(t, results)
+
+	results, err = ParseOutput(parser,
+		CommandOutput{
+			Content: file,
+		},
+		ResultMapping{
+			Level:           render.MustCompile(`{{ .level }}`),
+			Path:            render.MustCompile(`{{ .file }}`),
+			StartLine:       render.MustCompile(`{{ .line }}`),
+			StartColumn:     render.MustCompile(`{{ .column }}`),
+			EndLine:         render.MustCompile(`{{ .endLine }}`),
+			EndColumn:       render.MustCompile(`{{ .endColumn }}`),
+			RuleID:          render.MustCompile(`SC{{ .code }}`),
+			RuleName:        render.MustCompile(`SC{{ .code }}`),
+			RuleDescription: render.MustCompile(`{{ .message }}`),
+			RuleURI:         render.MustCompile(`https://www.shellcheck.net/wiki/SC{{ .code }}`),
+		},
+	)
+
+	assert.NoError(t, err)
+	require.Equal(t, 1, len(results))
+	assert.Equal(t, &Result{
+		Level: ResultLevelInfo,
+		Location: ResultLocation{
+			Path:        "entrypoint.sh",
+			StartLine:   15,
+			StartColumn: 6,
+			EndLine:     15,
+			EndColumn:   19,
+		},
+		Rule: ResultRule{
+			ID:          "SC2086",
+			Name:        "SC2086",
+			Description: "Double quote to prevent globbing and word splitting.",
+			URI:         "https://www.shellcheck.net/wiki/SC2086",
+		},
+	}, results[0])
+}
+
+func TestJSONLOutputParser_Parse(t *testing.T) {
+	mapping := ResultMapping{
+		Level:           render.MustCompile(`error`),
+		Path:            render.MustCompile(`{{ .filename }}`),
+		StartLine:       render.MustCompile(`{{ .location.row }}`),
+		StartColumn:     render.MustCompile(`{{ .location.column }}`),
+		EndLine:         render.MustCompile(`{{ .end_location.row }}`),
+		EndColumn:       render.MustCompile(`{{ .end_location.column }}`),
+		RuleID:          render.MustCompile(`{{ .code }}`),
+		RuleName:        render.MustCompile(`{{ .code }}`),
+		RuleDescription: render.MustCompile(`{{ .message }}`),
+		RuleURI:         render.MustCompile(`{{ .url }}`),
+	}
+
+	tests := []struct {
+		desc     string
+		content  io.Reader
+		mapping  ResultMapping
+		expected []*Result
+		err      string
+	}{
+		{
+			desc:     "returns an error when unable to read content",
+			content:  iotest.ErrReader(errors.New("boom")),
+			mapping:  mapping,
+			expected: nil,
+			err:      "boom",
+		},
+		{
+			desc:     "returns an empty slice when no content",
+			content:  bytes.NewBufferString(""),
+			mapping:  mapping,
+			expected: nil,
+			err:      "",
+		},
+		{
+			desc:     "returns an empty slice when no JSON lines",
+			content:  bytes.NewBufferString("something\n{{}\n"),
+			mapping:  mapping,
+			expected: nil,
+			err:      "",
+		},
+		{
+			desc:    "skips lines where the pattern is not an object or array",
+			content: bytes.NewBufferString(`{"issue": "foo"}` + "\n" + `{"issue": {"code": "a"}}`),
+			mapping: ResultMapping{
+				Pattern: "issue",
+				RuleID:  render.MustCompile(`{{ .code }}`),
+			},
+			expected: []*Result{
+				{Rule: ResultRule{ID: "a"}},
+			},
+			err: "",
+		},
+		{
+			desc:    "skips scalar lines",
+			content: bytes.NewBufferString("42\nnull\n\"progress...\"\n" + `{"code": "a"}`),
+			mapping: ResultMapping{
+				RuleID: render.MustCompile(`{{ .code }}`),
+			},
+			expected: []*Result{
+				{Rule: ResultRule{ID: "a"}},
+			},
+			err: "",
+		},
+		{
+			desc:    "returns an error when pattern is not an array of objects",
+			content: bytes.NewBufferString(`{"issues": ["foo"]}`),
+			mapping: ResultMapping{
+				Pattern: "issues",
+			},
+			expected: nil,
+			err:      "issues.0 is not an object",
+		},
+		{
+			desc:    "skips lines where the pattern does not exist",
+			content: bytes.NewBufferString(`{"other": {}}` + "\n" + `{"issues": [{"code": "a"}, {"code": "b"}]}`),
+			mapping: ResultMapping{
+				Pattern: "issues",
+				RuleID:  render.MustCompile(`{{ .code }}`),
+			},
+			expected: []*Result{
+				{Rule: ResultRule{ID: "a"}},
+				{Rule: ResultRule{ID: "b"}},
+			},
+			err: "",
+		},
+		{
+			desc:    "parses each JSON line and skips noise",
+			content: mustOpenFile("testdata/output/ruff.jsonl"),
+			mapping: mapping,
+			expected: []*Result{
+				{
+					Level: ResultLevelError,
+					Location: ResultLocation{
+						Path:        "app/main.py",
+						StartLine:   1,
+						StartColumn: 8,
+						EndLine:     1,
+						EndColumn:   10,
+					},
+					Rule: ResultRule{
+						ID:          "F401",
+						Name:        "F401",
+						Description: "`os` imported but unused",
+						URI:         "https://docs.astral.sh/ruff/rules/unused-import",
+					},
+				},
+				{
+					Level: ResultLevelError,
+					Location: ResultLocation{
+						Path:        "app/util.py",
+						StartLine:   12,
+						StartColumn: 89,
+						EndLine:     12,
+						EndColumn:   120,
+					},
+					Rule: ResultRule{
+						ID:          "E501",
+						Name:        "E501",
+						Description: "Line too long (120 > 88)",
+						URI:         "https://docs.astral.sh/ruff/rules/line-too-long",
+					},
+				},
+			},
+			err: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			actual, err := ParseOutput(&JSONLOutputParser{},
+				CommandOutput{
+					Content: tt.content,
+				},
+				tt.mapping,
+			)
+
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestRDJSONOutputParser_Parse(t *testing.T) {
+	tests := []struct {
+		desc     string
+		content  io.Reader
+		expected []*Result
+		err      string
+	}{
+		{
+			desc:     "returns an empty slice when no content",
+			content:  bytes.NewBufferString(""),
+			expected: nil,
 			err:      "",
 		},
 		{
@@ -453,46 +1076,79 @@ func TestSarifOutputParser_Parse(t *testing.T) {
 			err:      "boom",
 		},
 		{
-			desc:     "returns an error when not sarif content",
-			content:  bytes.NewBufferString("blah"),
+			desc:     "returns an error when not rdjson content",
+			content:  bytes.NewBufferString("not an rdjson document"),
 			expected: nil,
-			err:      "invalid sarif",
+			err:      "invalid rdjson",
 		},
 		{
-			desc:    "parses sarif output",
-			content: mustOpenFile("testdata/output/hadolint.sarif"),
+			desc:    "parses rdjson",
+			content: mustOpenFile("testdata/output/reviewdog.rdjson"),
 			expected: []*Result{
+				{
+					Level: ResultLevelError,
+					Location: ResultLocation{
+						Path:        "main.go",
+						StartLine:   14,
+						StartColumn: 15,
+						EndLine:     14,
+						EndColumn:   15,
+					},
+					Rule: ResultRule{
+						ID:          "unused",
+						Name:        "unused",
+						Description: "Unused variable",
+						URI:         "https://example.com/rules/unused",
+					},
+				},
 				{
 					Level: ResultLevelWarning,
 					Location: ResultLocation{
-						Path:        "Dockerfile",
-						StartLine:   44,
-						StartColumn: 1,
-						EndLine:     44,
-						EndColumn:   1,
+						Path:        "main.go",
+						StartLine:   20,
+						StartColumn: 3,
+						EndLine:     20,
+						EndColumn:   8,
 					},
 					Rule: ResultRule{
-						ID:   "DL4006",
-						Name: "DL4006",
-						Description: "Set the SHELL option -o pipefail before RUN with a pipe in it. " +
-							"If you are using /bin/sh in an alpine image or if your shell is " +
-							"symlinked to busybox then consider explicitly setting your SHELL " +
-							"to /bin/ash, or disable this check",
+						Description: "Prefer single quotes",
+					},
+					Fixes: []*ResultFix{
+						{
+							Location: ResultLocation{
+								Path:        "main.go",
+								StartLine:   20,
+								StartColumn: 3,
+								EndLine:     20,
+								EndColumn:   8,
+							},
+							Replacement: "'foo'",
+						},
 					},
 				},
 				{
 					Level: ResultLevelWarning,
 					Location: ResultLocation{
-						Path:        "Dockerfile",
-						StartLine:   44,
-						StartColumn: 1,
-						EndLine:     44,
-						EndColumn:   1,
+						Path:        "main.go",
+						StartLine:   22,
+						StartColumn: 10,
+						EndLine:     22,
+						EndColumn:   10,
 					},
 					Rule: ResultRule{
-						ID:          "SC3044",
-						Name:        "SC3044",
-						Description: "In POSIX sh, 'popd' is undefined.",
+						Description: "Missing semicolon",
+					},
+					Fixes: []*ResultFix{
+						{
+							Location: ResultLocation{
+								Path:        "main.go",
+								StartLine:   22,
+								StartColumn: 10,
+								EndLine:     22,
+								EndColumn:   10,
+							},
+							Replacement: ";",
+						},
 					},
 				},
 			},
@@ -501,7 +1157,7 @@ func TestSarifOutputParser_Parse(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.desc, func(t *testing.T) {
-			actual, err := (&SarifOutputParser{}).Parse(
+			actual, err := ParseOutput(&RDJSONOutputParser{},
 				CommandOutput{
 					Content: tt.content,
 				},
@@ -518,3 +1174,1023 @@ func TestSarifOutputParser_Parse(t *testing.T) {
 		})
 	}
 }
+
+func TestRDJSONLOutputParser_Parse(t *testing.T) {
+	tests := []struct {
+		desc     string
+		content  io.Reader
+		expected []*Result
+		err      string
+	}{
+		{
+			desc:     "returns an empty slice when no content",
+			content:  bytes.NewBufferString(""),
+			expected: nil,
+			err:      "",
+		},
+		{
+			desc:     "returns an error when unable to read content",
+			content:  iotest.ErrReader(errors.New("boom")),
+			expected: nil,
+			err:      "boom",
+		},
+		{
+			desc:     "returns an error when not rdjsonl content",
+			content:  bytes.NewBufferString("not an rdjsonl document"),
+			expected: nil,
+			err:      "invalid rdjsonl",
+		},
+		{
+			desc:    "parses rdjsonl",
+			content: mustOpenFile("testdata/output/reviewdog.rdjsonl"),
+			expected: []*Result{
+				{
+					Level: ResultLevelError,
+					Location: ResultLocation{
+						Path:        "main.go",
+						StartLine:   14,
+						StartColumn: 15,
+						EndLine:     14,
+						EndColumn:   15,
+					},
+					Rule: ResultRule{
+						ID:          "unused",
+						Name:        "unused",
+						Description: "Unused variable",
+						URI:         "https://example.com/rules/unused",
+					},
+				},
+				{
+					Level: ResultLevelInfo,
+					Location: ResultLocation{
+						Path:        "main.go",
+						StartLine:   20,
+						StartColumn: 3,
+						EndLine:     20,
+						EndColumn:   8,
+					},
+					Rule: ResultRule{
+						Description: "Prefer single quotes",
+					},
+					Fixes: []*ResultFix{
+						{
+							Location: ResultLocation{
+								Path:        "main.go",
+								StartLine:   20,
+								StartColumn: 3,
+								EndLine:     20,
+								EndColumn:   8,
+							},
+							Replacement: "'foo'",
+						},
+					},
+				},
+			},
+			err: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			actual, err := ParseOutput(&RDJSONLOutputParser{},
+				CommandOutput{
+					Content: tt.content,
+				},
+				ResultMapping{},
+			)
+
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestRegexpOutputParser_Parse(t *testing.T) {
+	mapping := ResultMapping{
+		Pattern: strings.Join([]string{
+			`(?m)`,
+			`Secret:\s+(?P<secret>.*)`,
+			`RuleID:\s+(?P<rule_id>.*)`,
+			`Entropy:\s+(?P<entropy>.*)`,
+			`File:\s+(?P<file>.*)`,
+			`Line:\s+(?P<line>.*)`,
+		}, `\n`),
+		Level:           render.MustCompile(`error`),
+		Path:            render.MustCompile(`{{ .file }}`),
+		StartLine:       render.MustCompile(`{{ .line }}`),
+		RuleID:          render.MustCompile(`{{ .rule_id }}`),
+		RuleName:        render.MustCompile(`{{ .rule_id }}`),
+		RuleDescription: render.MustCompile(`Secret detected: {{ .secret }}`),
+	}
+
+	tests := []struct {
+		desc     string
+		content  io.Reader
+		mapping  ResultMapping
+		expected []*Result
+		err      string
+	}{
+		{
+			desc:     "returns an error when unable to read content",
+			content:  iotest.ErrReader(errors.New("boom")),
+			mapping:  mapping,
+			expected: nil,
+			err:      "boom",
+		},
+		{
+			desc:    "returns an error if pattern is missing",
+			content: mustOpenFile("testdata/output/gitleaks.txt"),
+			mapping: ResultMapping{
+				Pattern: "",
+			},
+			expected: nil,
+			err:      "pattern is required",
+		},
+		{
+			desc:    "returns an error if pattern is malformed",
+			content: mustOpenFile("testdata/output/gitleaks.txt"),
+			mapping: ResultMapping{
+				Pattern: "(?lol)",
+			},
+			expected: nil,
+			err:      "error parsing regexp",
+		},
+		{
+			desc:     "returns an empty slice when no content",
+			content:  bytes.NewBufferString(""),
+			mapping:  mapping,
+			expected: nil,
+			err:      "",
+		},
+		{
+			desc:     "returns an empty slice when nothing matches",
+			content:  bytes.NewBufferString("something"),
+			mapping:  mapping,
+			expected: nil,
+			err:      "",
+		},
+		{
+			desc:    "parses text using a regexp pattern",
+			content: mustOpenFile("testdata/output/gitleaks.txt"),
+			mapping: mapping,
+			expected: []*Result{
+				{
+					Level: ResultLevelError,
+					Location: ResultLocation{
+						Path:      ".env",
+						StartLine: 8,
+					},
+					Rule: ResultRule{
+						ID:          "github-oauth",
+						Name:        "github-oauth",
+						Description: "Secret detected: REDACTED",
+					},
+				},
+				{
+					Level: ResultLevelError,
+					Location: ResultLocation{
+						Path:      "bin/run-in-container.sh",
+						StartLine: 22,
+					},
+					Rule: ResultRule{
+						ID:          "github-oauth",
+						Name:        "github-oauth",
+						Description: "Secret detected: REDACTED",
+					},
+				},
+				{
+					Level: ResultLevelError,
+					Location: ResultLocation{
+						Path:      "etc/githooks/commit-msg",
+						StartLine: 10,
+					},
+					Rule: ResultRule{
+						ID:          "github-oauth",
+						Name:        "github-oauth",
+						Description: "Secret detected: REDACTED",
+					},
+				},
+			},
+			err: "",
+		},
+		{
+			desc:    "matches ^ against the start of the output",
+			content: bytes.NewBufferString("error: one\nerror: two\n"),
+			mapping: ResultMapping{
+				Pattern:         `^error: (?P<msg>.*)`,
+				Level:           render.MustCompile(`error`),
+				RuleDescription: render.MustCompile(`{{ .msg }}`),
+			},
+			expected: []*Result{
+				{
+					Level: ResultLevelError,
+					Rule:  ResultRule{Description: "one"},
+				},
+			},
+			err: "",
+		},
+		{
+			desc: "matches patterns spanning any number of lines",
+			content: bytes.NewBufferString(
+				"BEGIN\n" + strings.Repeat("line\n", 150) + "END\n",
+			),
+			mapping: ResultMapping{
+				Pattern:         `(?s)BEGIN\n(?P<body>.*)END`,
+				Level:           render.MustCompile(`error`),
+				RuleDescription: render.MustCompile(`{{ .body | splitList "\n" | len }} lines`),
+			},
+			expected: []*Result{
+				{
+					Level: ResultLevelError,
+					Rule:  ResultRule{Description: "151 lines"},
+				},
+			},
+			err: "",
+		},
+		{
+			desc:    "matches single line patterns against each line",
+			content: bytes.NewBufferString("warning: one\nwarning: two\n"),
+			mapping: ResultMapping{
+				Pattern:         `(?m)^warning: (?P<msg>.*)$`,
+				Level:           render.MustCompile(`warning`),
+				RuleDescription: render.MustCompile(`{{ .msg }}`),
+			},
+			expected: []*Result{
+				{
+					Level: ResultLevelWarning,
+					Rule:  ResultRule{Description: "one"},
+				},
+				{
+					Level: ResultLevelWarning,
+					Rule:  ResultRule{Description: "two"},
+				},
+			},
+			err: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			actual, err := ParseOutput(&RegexpOutputParser{},
+				CommandOutput{
+					Content: tt.content,
+				},
+				tt.mapping,
+			)
+
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestRegexpOutputParser_Parse_Streaming(t *testing.T) {
+	// Single line patterns are emitted as each line is read.
+	content := io.MultiReader(
+		bytes.NewBufferString("warning: one\n"),
+		iotest.ErrReader(errors.New("boom")),
+	)
+	mapping := ResultMapping{
+		Pattern:         `warning: (?P<msg>.*)`,
+		Level:           render.MustCompile(`warning`),
+		RuleDescription: render.MustCompile(`{{ .msg }}`),
+	}
+
+	emitted := []*Result{}
+	err := (&RegexpOutputParser{}).Parse(CommandOutput{Content: content}, mapping, func(r *Result) error {
+		emitted = append(emitted, r)
+		return nil
+	})
+
+	assert.ErrorContains(t, err, "boom")
+	assert.Equal(t, []*Result{
+		{
+			Level: ResultLevelWarning,
+			Rule:  ResultRule{Description: "one"},
+		},
+	}, emitted)
+}
+
+func TestRegexpSpansLines(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		expected bool
+	}{
+		{pattern: `(?P<file>.*):(?P<line>\d+)`, expected: false},
+		{pattern: `(?m)^(?P<file>[^:\n]+):(?P<line>\d+)$`, expected: false},
+		{pattern: `^(?P<file>.*)`, expected: true},
+		{pattern: `(?P<file>.*)$`, expected: true},
+		{pattern: `\A(?P<file>.*)`, expected: true},
+		{pattern: `(?P<file>[^:]+):`, expected: true},
+		{pattern: `File:\s+(?P<file>.*)`, expected: true},
+		{pattern: `(?s)File: (?P<file>.*)`, expected: true},
+		{pattern: `File:\n(?P<file>.*)`, expected: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			assert.Equal(t, tt.expected, regexpSpansLines(tt.pattern))
+		})
+	}
+}
+
+func TestSarifOutputParser_Parse(t *testing.T) {
+	tests := []struct {
+		desc     string
+		content  io.Reader
+		expected []*Result
+		err      string
+	}{
+		{
+			desc:     "returns an empty slice when no content",
+			content:  bytes.NewBufferString(""),
+			expected: nil,
+			err:      "",
+		},
+		{
+			desc:     "returns an error when unable to read content",
+			content:  iotest.ErrReader(errors.New("boom")),
+			expected: nil,
+			err:      "boom",
+		},
+		{
+			desc:     "returns an error when not sarif content",
+			content:  bytes.NewBufferString("blah"),
+			expected: nil,
+			err:      "invalid sarif",
+		},
+		{
+			desc:    "parses sarif output",
+			content: mustOpenFile("testdata/output/hadolint.sarif"),
+			expected: []*Result{
+				{
+					Level: ResultLevelWarning,
+					Location: ResultLocation{
+						Path:        "Dockerfile",
+						StartLine:   44,
+						StartColumn: 1,
+						EndLine:     44,
+						EndColumn:   1,
+					},
+					Rule: ResultRule{
+						ID:   "DL4006",
+						Name: "DL4006",
+						Description: "Set the SHELL option -o pipefail before RUN with a pipe in it. " +
+							"If you are using /bin/sh in an alpine image or if your shell is " +
+							"symlinked to busybox then consider explicitly setting your SHELL " +
+							"to /bin/ash, or disable this check",
+					},
+				},
+				{
+					Level: ResultLevelWarning,
+					Location: ResultLocation{
+						Path:        "Dockerfile",
+						StartLine:   44,
+						StartColumn: 1,
+						EndLine:     44,
+						EndColumn:   1,
+					},
+					Rule: ResultRule{
+						ID:          "SC3044",
+						Name:        "SC3044",
+						Description: "In POSIX sh, 'popd' is undefined.",
+					},
+				},
+			},
+			err: "",
+		},
+		{
+			desc:    "parses sarif fixes",
+			content: mustOpenFile("testdata/output/fixes.sarif"),
+			expected: []*Result{
+				{
+					Level: ResultLevelError,
+					Location: ResultLocation{
+						Path:        "main.go",
+						StartLine:   3,
+						StartColumn: 5,
+						EndLine:     3,
+						EndColumn:   9,
+					},
+					Rule: ResultRule{
+						ID:          "println",
+						Name:        "println",
+						Description: "Use fmt.Println",
+					},
+					Fixes: []*ResultFix{
+						{
+							Location: ResultLocation{
+								Path:        "main.go",
+								StartLine:   3,
+								StartColumn: 5,
+								EndLine:     3,
+								EndColumn:   9,
+							},
+							Replacement: "fmt.Println",
+							columnKind:  columnKindUTF16CodeUnits,
+						},
+						{
+							Location: ResultLocation{
+								Path: "main.go",
+							},
+							ByteOffset:  0,
+							ByteLength:  0,
+							Replacement: "// Package main.\n",
+							columnKind:  columnKindUTF16CodeUnits,
+						},
+					},
+				},
+			},
+			err: "",
+		},
+		{
+			desc: "honors the run column kind",
+			content: bytes.NewBufferString(`{
+				"version": "2.1.0",
+				"runs": [{
+					"tool": {"driver": {"name": "test"}},
+					"columnKind": "unicodeCodePoints",
+					"results": [{
+						"message": {"text": "fix"},
+						"fixes": [{"artifactChanges": [null, {
+							"artifactLocation": {"uri": "main.go"},
+							"replacements": [{
+								"deletedRegion": {"startLine": 1, "startColumn": 2},
+								"insertedContent": {"text": "x"}
+							}]
+						}]}]
+					}]
+				}]
+			}`),
+			expected: []*Result{
+				{
+					Level: ResultLevelWarning,
+					Rule:  ResultRule{Description: "fix"},
+					Fixes: []*ResultFix{
+						{
+							Location:    ResultLocation{Path: "main.go", StartLine: 1, StartColumn: 2},
+							Replacement: "x",
+							columnKind:  columnKindUnicodeCodePoints,
+						},
+					},
+				},
+			},
+			err: "",
+		},
+		{
+			desc: "skips fixes with regions that can not be resolved",
+			content: bytes.NewBufferString(`{
+				"version": "2.1.0",
+				"runs": [{
+					"tool": {"driver": {"name": "test"}},
+					"results": [{
+						"message": {"text": "fix"},
+						"fixes": [{"artifactChanges": [{
+							"artifactLocation": {"uri": "main.go"},
+							"replacements": [
+								{"deletedRegion": {"startLine": 1}, "insertedContent": {"text": "x"}},
+								{"deletedRegion": {"charOffset": 10, "charLength": 2}, "insertedContent": {"text": "y"}}
+							]
+						}]}]
+					}]
+				}]
+			}`),
+			expected: []*Result{
+				{
+					Level: ResultLevelWarning,
+					Rule:  ResultRule{Description: "fix"},
+				},
+			},
+			err: "",
+		},
+		{
+			desc: "handles missing optional fields",
+			content: bytes.NewBufferString(`{
+				"version": "2.1.0",
+				"runs": [{
+					"tool": {"driver": {"name": "test"}},
+					"results": [
+						{"message": {}, "locations": [{"physicalLocation": {"region": {"startLine": 2}}}]},
+						{"message": {"text": "no location"}, "locations": [{}]}
+					]
+				}]
+			}`),
+			expected: []*Result{
+				{
+					Level:    ResultLevelWarning,
+					Location: ResultLocation{StartLine: 2},
+				},
+				{
+					Level: ResultLevelWarning,
+					Rule: ResultRule{
+						Description: "no location",
+					},
+				},
+			},
+			err: "",
+		},
+		{
+			desc:    "resolves rules, message strings, and base uris",
+			content: mustOpenFile("testdata/output/codeql.sarif"),
+			expected: []*Result{
+				{
+					Level: ResultLevelInfo,
+					Location: ResultLocation{
+						Path:        "testdata/src/app.js",
+						StartLine:   3,
+						StartColumn: 7,
+					},
+					Rule: ResultRule{
+						ID:          "js/unused-local-variable",
+						Name:        "js/unused-local-variable",
+						Description: "Unused variable foo.",
+						URI:         "https://codeql.github.com/codeql-query-help/javascript/js-unused-local-variable/",
+					},
+				},
+				{
+					Level: ResultLevelError,
+					Location: ResultLocation{
+						Path:        "testdata/src/db.js",
+						StartLine:   20,
+						StartColumn: 5,
+						EndLine:     20,
+						EndColumn:   30,
+					},
+					Rule: ResultRule{
+						ID:          "js/sql-injection",
+						Name:        "js/sql-injection",
+						Description: "This query depends on a [user-provided value](relative:///app.js:10:15:10:22).",
+						URI:         "https://codeql.github.com/codeql-query-help/javascript/js-sql-injection/",
+					},
+					RelatedLocations: []*ResultRelatedLocation{
+						{
+							Location: ResultLocation{
+								Path:        "testdata/src/app.js",
+								StartLine:   10,
+								StartColumn: 15,
+								EndColumn:   22,
+							},
+							Message: "user-provided value from req.query",
+						},
+					},
+					CodeFlows: []*ResultCodeFlow{
+						{
+							Message: "Taint flow",
+							Locations: []*ResultRelatedLocation{
+								{
+									Location: ResultLocation{
+										Path:        "testdata/src/app.js",
+										StartLine:   10,
+										StartColumn: 15,
+									},
+									Message: "req.query",
+								},
+								{
+									Location: ResultLocation{
+										Path:        "testdata/src/db.js",
+										StartLine:   20,
+										StartColumn: 5,
+									},
+									Message: "query",
+								},
+							},
+						},
+					},
+				},
+				{
+					Level: ResultLevelWarning,
+					Location: ResultLocation{
+						Path: "lib.js",
+					},
+					Rule: ResultRule{
+						ID:          "custom/debugger",
+						Name:        "NoDebugger",
+						Description: "Unexpected debugger statement.",
+						URI:         "https://example.com/rules/debugger",
+					},
+				},
+				{
+					Level: ResultLevelNone,
+					Rule: ResultRule{
+						ID:          "js/unused-local-variable",
+						Name:        "js/unused-local-variable",
+						Description: "Checked.",
+						URI:         "https://codeql.github.com/codeql-query-help/javascript/js-unused-local-variable/",
+					},
+				},
+			},
+			err: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			actual, err := ParseOutput(&SarifOutputParser{},
+				CommandOutput{
+					Content: tt.content,
+				},
+				ResultMapping{},
+			)
+
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestTOMLOutputParser_Parse(t *testing.T) {
+	mapping := ResultMapping{
+		Pattern:         "issues",
+		Level:           render.MustCompile(`{{ .severity }}`),
+		Path:            render.MustCompile(`{{ .file }}`),
+		StartLine:       render.MustCompile(`{{ .line }}`),
+		RuleID:          render.MustCompile(`{{ .rule }}`),
+		RuleName:        render.MustCompile(`{{ .rule }}`),
+		RuleDescription: render.MustCompile(`{{ .message }}`),
+	}
+
+	tests := []struct {
+		desc     string
+		content  io.Reader
+		mapping  ResultMapping
+		expected []*Result
+		err      string
+	}{
+		{
+			desc:     "returns an error when unable to read content",
+			content:  iotest.ErrReader(errors.New("boom")),
+			mapping:  mapping,
+			expected: nil,
+			err:      "boom",
+		},
+		{
+			desc:     "returns an empty slice when no content",
+			content:  bytes.NewBufferString(""),
+			mapping:  mapping,
+			expected: nil,
+			err:      "",
+		},
+		{
+			desc:     "returns an error when not toml content",
+			content:  bytes.NewBufferString("blah"),
+			mapping:  mapping,
+			expected: nil,
+			err:      "invalid toml",
+		},
+		{
+			desc:     "returns an error when pattern is not an array",
+			content:  bytes.NewBufferString(`issues = "foo"`),
+			mapping:  mapping,
+			expected: nil,
+			err:      "pattern=issues is not an array",
+		},
+		{
+			desc:    "parses toml output",
+			content: mustOpenFile("testdata/output/report.toml"),
+			mapping: mapping,
+			expected: []*Result{
+				{
+					Level: ResultLevelWarning,
+					Location: ResultLocation{
+						Path:      "config/app.toml",
+						StartLine: 3,
+					},
+					Rule: ResultRule{
+						ID:          "deprecated-key",
+						Name:        "deprecated-key",
+						Description: "Key 'timeout' is deprecated",
+					},
+				},
+				{
+					Level: ResultLevelError,
+					Location: ResultLocation{
+						Path:      "config/db.toml",
+						StartLine: 12,
+					},
+					Rule: ResultRule{
+						ID:          "missing-key",
+						Name:        "missing-key",
+						Description: "Key 'password' is required",
+					},
+				},
+			},
+			err: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			actual, err := ParseOutput(&TOMLOutputParser{},
+				CommandOutput{
+					Content: tt.content,
+				},
+				tt.mapping,
+			)
+
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestXMLOutputParser_Parse(t *testing.T) {
+	tests := []struct {
+		desc     string
+		content  io.Reader
+		mapping  ResultMapping
+		expected []*Result
+		err      string
+	}{
+		{
+			desc:     "returns an error when pattern is missing",
+			content:  bytes.NewBufferString("<results/>"),
+			mapping:  ResultMapping{},
+			expected: nil,
+			err:      "mapping pattern is required",
+		},
+		{
+			desc:     "returns an error when pattern is invalid",
+			content:  bytes.NewBufferString("<results/>"),
+			mapping:  ResultMapping{Pattern: "//error["},
+			expected: nil,
+			err:      "invalid xpath",
+		},
+		{
+			desc:     "returns an error when unable to read content",
+			content:  iotest.ErrReader(errors.New("boom")),
+			mapping:  ResultMapping{Pattern: "//error"},
+			expected: nil,
+			err:      "boom",
+		},
+		{
+			desc:     "returns an empty slice when no content",
+			content:  bytes.NewBufferString(" \n"),
+			mapping:  ResultMapping{Pattern: "//error"},
+			expected: nil,
+			err:      "",
+		},
+		{
+			desc:     "returns an error when not xml content",
+			content:  bytes.NewBufferString("blah"),
+			mapping:  ResultMapping{Pattern: "//error"},
+			expected: nil,
+			err:      "invalid xml",
+		},
+		{
+			desc:     "returns an error when pattern selects attributes",
+			content:  bytes.NewBufferString(`<results><error line="1"/></results>`),
+			mapping:  ResultMapping{Pattern: "//error/@line"},
+			expected: nil,
+			err:      "must select elements",
+		},
+		{
+			desc:    "maps attributes, text, and parent attributes",
+			content: mustOpenFile("testdata/output/pmd.xml"),
+			mapping: ResultMapping{
+				Pattern:         "//file/violation",
+				Level:           render.MustCompile(`{{ if eq .priority "1" }}error{{ else }}warning{{ end }}`),
+				Path:            render.MustCompile(`{{ .parent.name }}`),
+				StartLine:       render.MustCompile(`{{ .beginline }}`),
+				StartColumn:     render.MustCompile(`{{ .begincolumn }}`),
+				EndLine:         render.MustCompile(`{{ .endline }}`),
+				EndColumn:       render.MustCompile(`{{ .endcolumn }}`),
+				RuleID:          render.MustCompile(`{{ .rule }}`),
+				RuleName:        render.MustCompile(`{{ .parent.parent.version }}/{{ .rule }}`),
+				RuleDescription: render.MustCompile(`{{ .text }}`),
+				RuleURI:         render.MustCompile(`{{ .externalInfoUrl }}`),
+			},
+			expected: []*Result{
+				{
+					Level: ResultLevelWarning,
+					Location: ResultLocation{
+						Path:        "src/main/java/App.java",
+						StartLine:   5,
+						StartColumn: 9,
+						EndLine:     5,
+						EndColumn:   22,
+					},
+					Rule: ResultRule{
+						ID:          "UnusedLocalVariable",
+						Name:        "7.0.0/UnusedLocalVariable",
+						Description: "Avoid unused local variables such as 'count'.",
+						URI:         "https://docs.pmd-code.org/pmd-doc-7.0.0/pmd_rules_java_bestpractices.html#unusedlocalvariable",
+					},
+				},
+				{
+					Level: ResultLevelError,
+					Location: ResultLocation{
+						Path:        "src/main/java/App.java",
+						StartLine:   12,
+						StartColumn: 5,
+						EndLine:     14,
+						EndColumn:   6,
+					},
+					Rule: ResultRule{
+						ID:          "EmptyCatchBlock",
+						Name:        "7.0.0/EmptyCatchBlock",
+						Description: "Avoid empty catch blocks",
+						URI:         "https://docs.pmd-code.org/pmd-doc-7.0.0/pmd_rules_java_errorprone.html#emptycatchblock",
+					},
+				},
+			},
+			err: "",
+		},
+		{
+			desc:    "maps child elements",
+			content: mustOpenFile("testdata/output/cppcheck.xml"),
+			mapping: ResultMapping{
+				Pattern:         "//errors/error",
+				Level:           render.MustCompile(`{{ .severity }}`),
+				Path:            render.MustCompile(`{{ .location.file }}`),
+				StartLine:       render.MustCompile(`{{ .location.line }}`),
+				StartColumn:     render.MustCompile(`{{ .location.column }}`),
+				RuleID:          render.MustCompile(`{{ .id }}`),
+				RuleName:        render.MustCompile(`{{ .id }}`),
+				RuleDescription: render.MustCompile(`{{ .msg }} ({{ .symbol.text }})`),
+			},
+			expected: []*Result{
+				{
+					Level: ResultLevelError,
+					Location: ResultLocation{
+						Path:        "src/main.c",
+						StartLine:   7,
+						StartColumn: 6,
+					},
+					Rule: ResultRule{
+						ID:          "nullPointer",
+						Name:        "nullPointer",
+						Description: "Null pointer dereference: p (p)",
+					},
+				},
+				{
+					Level: ResultLevelInfo,
+					Location: ResultLocation{
+						Path:        "src/util.c",
+						StartLine:   3,
+						StartColumn: 9,
+					},
+					Rule: ResultRule{
+						ID:          "unusedVariable",
+						Name:        "unusedVariable",
+						Description: "Unused variable: x (x)",
+					},
+				},
+			},
+			err: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			actual, err := ParseOutput(&XMLOutputParser{},
+				CommandOutput{
+					Content: tt.content,
+				},
+				tt.mapping,
+			)
+
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestYAMLOutputParser_Parse(t *testing.T) {
+	tests := []struct {
+		desc     string
+		content  io.Reader
+		mapping  ResultMapping
+		expected []*Result
+		err      string
+	}{
+		{
+			desc:     "returns an error when unable to read content",
+			content:  iotest.ErrReader(errors.New("boom")),
+			mapping:  ResultMapping{},
+			expected: nil,
+			err:      "boom",
+		},
+		{
+			desc:     "returns an empty slice when no content",
+			content:  bytes.NewBufferString("---\n"),
+			mapping:  ResultMapping{},
+			expected: nil,
+			err:      "",
+		},
+		{
+			desc:     "returns an error when not yaml content",
+			content:  bytes.NewBufferString("foo: [bar"),
+			mapping:  ResultMapping{},
+			expected: nil,
+			err:      "invalid yaml",
+		},
+		{
+			desc:     "returns an error when pattern is not an array of objects",
+			content:  bytes.NewBufferString("issues:\n  - foo\n"),
+			mapping:  ResultMapping{Pattern: "issues"},
+			expected: nil,
+			err:      "pattern=issues.0 is not an object",
+		},
+		{
+			desc:    "parses yaml output",
+			content: mustOpenFile("testdata/output/kube-linter.yaml"),
+			mapping: ResultMapping{
+				Pattern:         "Reports",
+				Level:           render.MustCompile(`error`),
+				Path:            render.MustCompile(`{{ .Object.Metadata.FilePath }}`),
+				RuleID:          render.MustCompile(`{{ .Check }}`),
+				RuleName:        render.MustCompile(`{{ .Check }}`),
+				RuleDescription: render.MustCompile(`{{ .Diagnostic.Message }}`),
+			},
+			expected: []*Result{
+				{
+					Level: ResultLevelError,
+					Location: ResultLocation{
+						Path: "deploy/app.yaml",
+					},
+					Rule: ResultRule{
+						ID:          "no-read-only-root-fs",
+						Name:        "no-read-only-root-fs",
+						Description: `container "app" does not have a read-only root file system`,
+					},
+				},
+				{
+					Level: ResultLevelError,
+					Location: ResultLocation{
+						Path: "deploy/app.yaml",
+					},
+					Rule: ResultRule{
+						ID:          "run-as-non-root",
+						Name:        "run-as-non-root",
+						Description: `container "app" is not set to runAsNonRoot`,
+					},
+				},
+			},
+			err: "",
+		},
+		{
+			desc: "treats multiple documents as an array",
+			content: bytes.NewBufferString(
+				"file: a.yaml\nline: 1\n---\nfile: b.yaml\nline: 2\n---\n",
+			),
+			mapping: ResultMapping{
+				Path:      render.MustCompile(`{{ .file }}`),
+				StartLine: render.MustCompile(`{{ .line }}`),
+			},
+			expected: []*Result{
+				{Location: ResultLocation{Path: "a.yaml", StartLine: 1}},
+				{Location: ResultLocation{Path: "b.yaml", StartLine: 2}},
+			},
+			err: "",
+		},
+		{
+			desc:    "converts non-string keys",
+			content: bytes.NewBufferString("- 1: a.yaml\n  true: 3\n"),
+			mapping: ResultMapping{
+				Path:      render.MustCompile(`{{ index . "1" }}`),
+				StartLine: render.MustCompile(`{{ index . "true" }}`),
+			},
+			expected: []*Result{
+				{Location: ResultLocation{Path: "a.yaml", StartLine: 3}},
+			},
+			err: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			actual, err := ParseOutput(&YAMLOutputParser{},
+				CommandOutput{
+					Content: tt.content,
+				},
+				tt.mapping,
+			)
+
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package stylist
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/twelvelabs/termite/ui"
+)
+
+// OutputTarget is a destination for the results in a specific format.
+// An empty path (or "-") means stdout.
+type OutputTarget struct {
+	Format ResultFormat `yaml:"format"`
+	Path   string       `yaml:"path,omitempty"`
+}
+
+// ParseOutputTarget parses a target in the form "FORMAT=PATH" (or "FORMAT").
+func ParseOutputTarget(value string) (*OutputTarget, error) {
+	name, path, _ := strings.Cut(value, "=")
+	format, err := ParseResultFormat(strings.TrimSpace(name))
+	if err != nil {
+		return nil, fmt.Errorf("invalid output %q: %w", value, err)
+	}
+	return &OutputTarget{
+		Format: format,
+		Path:   strings.TrimSpace(path),
+	}, nil
+}
+
+// String returns the target in the form "FORMAT=PATH".
+func (t *OutputTarget) String() string {
+	if t.isStdout() {
+		return t.Format.String()
+	}
+	return t.Format.String() + "=" + t.Path
+}
+
+func (t *OutputTarget) isStdout() bool {
+	return t.Path == "" || t.Path == "-"
+}
+
+// PrintResults prints the results to each of the configured output targets.
+// When there are no targets, the results are printed to stdout
+// using the configured format.
+func PrintResults(ios *ui.IOStreams, config *Config, results []*Result, run *RunInfo) error {
+	if len(config.Output.Targets) == 0 {
+		return NewResultPrinter(ios, config, run).Print(results)
+	}
+
+	for _, target := range config.Output.Targets {
+		if err := printResultsToTarget(ios, config, target, results, run); err != nil {
+			return fmt.Errorf("output %s: %w", target, err)
+		}
+	}
+	return nil
+}
+
+func printResultsToTarget(
+	ios *ui.IOStreams, config *Config, target *OutputTarget, results []*Result, run *RunInfo,
+) error {
+	targetConfig := *config
+	targetConfig.Output.Format = target.Format
+
+	if target.isStdout() {
+		return NewResultPrinter(ios, &targetConfig, run).Print(copyResults(results))
+	}
+
+	if err := os.MkdirAll(filepath.Dir(target.Path), 0755); err != nil { //nolint:gosec
+		return err
+	}
+	file, err := os.Create(target.Path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// Color is disabled by default, which is what we want for files.
+	fileIOS := &ui.IOStreams{
+		In:  ios.In,
+		Out: &fileIOStream{File: file},
+		Err: ios.Err,
+	}
+	if err := NewResultPrinter(fileIOS, &targetConfig, run).Print(copyResults(results)); err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+// Some printers mutate the results (i.e. to strip context lines),
+// so each target gets its own shallow copy.
+func copyResults(results []*Result) []*Result {
+	copied := make([]*Result, 0, len(results))
+	for _, r := range results {
+		c := *r
+		copied = append(copied, &c)
+	}
+	return copied
+}
+
+// fileIOStream adapts an os.File to the ui.IOStream interface.
+type fileIOStream struct {
+	*os.File
+	written int64
+}
+
+func (f *fileIOStream) Write(p []byte) (int, error) {
+	n, err := f.File.Write(p)
+	f.written += int64(n)
+	return n, err
+}
+
+// String returns everything written to the file so far
+// (regardless of the current offset).
+func (f *fileIOStream) String() string {
+	buf := make([]byte, f.written)
+	n, _ := f.ReadAt(buf, 0)
+	return string(buf[:n])
+}
+
+func (f *fileIOStream) Lines() []string {
+	return strings.Split(strings.TrimSuffix(f.String(), "\n"), "\n")
+}
@@ -0,0 +1,147 @@
+package stylist
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twelvelabs/termite/testutil"
+)
+
+func TestParseOutputTarget(t *testing.T) {
+	tests := []struct {
+		desc     string
+		value    string
+		expected *OutputTarget
+		err      string
+	}{
+		{
+			desc:     "parses format and path",
+			value:    "sarif=reports/stylist.sarif",
+			expected: &OutputTarget{Format: ResultFormatSarif, Path: "reports/stylist.sarif"},
+		},
+		{
+			desc:     "parses format only",
+			value:    "tty",
+			expected: &OutputTarget{Format: ResultFormatTty},
+		},
+		{
+			desc:     "returns an error for unknown formats",
+			value:    "nope=out.txt",
+			expected: nil,
+			err:      "invalid output \"nope=out.txt\"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			actual, err := ParseOutputTarget(tt.value)
+
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestOutputTarget_String(t *testing.T) {
+	assert.Equal(t, "tty", (&OutputTarget{Format: ResultFormatTty}).String())
+	assert.Equal(t, "tty", (&OutputTarget{Format: ResultFormatTty, Path: "-"}).String())
+	assert.Equal(t, "json=out.json", (&OutputTarget{Format: ResultFormatJson, Path: "out.json"}).String())
+}
+
+func TestPrintResults(t *testing.T) {
+	results := []*Result{
+		{
+			Source: "test-linter",
+			Level:  ResultLevelError,
+			Location: ResultLocation{
+				Path:        "foo.go",
+				StartLine:   1,
+				StartColumn: 2,
+			},
+			Rule: ResultRule{
+				ID:          "rule-id1",
+				Description: "some issue",
+			},
+			ContextLines: []string{"context"},
+		},
+	}
+
+	t.Run("prints the configured format to stdout when no targets", func(t *testing.T) {
+		app := NewTestApp()
+		app.Config.Output.Format = ResultFormatTty
+		app.Config.Output.ShowContext = false
+
+		err := PrintResults(app.IO, app.Config, results, &RunInfo{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"foo.go:1:2: error: test-linter: some issue. [rule-id1]",
+		}, app.IO.Out.Lines())
+	})
+
+	t.Run("prints to each target", func(t *testing.T) {
+		testutil.InTempDir(t, func(dir string) {
+			app := NewTestApp()
+			app.Config.Output.ShowContext = true
+			app.Config.Output.Targets = []*OutputTarget{
+				{Format: ResultFormatTty},
+				{Format: ResultFormatJson, Path: "reports/stylist.json"},
+			}
+
+			err := PrintResults(app.IO, app.Config, results, &RunInfo{})
+			require.NoError(t, err)
+			assert.Equal(t, []string{
+				"foo.go:1:2: error: test-linter: some issue. [rule-id1]",
+				"context",
+				" ^",
+			}, app.IO.Out.Lines())
+			testutil.AssertFilePath(t, "reports/stylist.json",
+				`[{"source":"test-linter","level":"error",`+
+					`"location":{"path":"foo.go","start_line":1,"start_column":2,"end_line":0,"end_column":0},`+
+					`"rule":{"id":"rule-id1","name":"","description":"some issue","uri":""},`+
+					`"context_lines":["context"]}]`+"\n",
+			)
+		})
+	})
+
+	t.Run("returns an error when unable to write a target", func(t *testing.T) {
+		testutil.InTempDir(t, func(dir string) {
+			app := NewTestApp()
+			testutil.WriteFile(t, "reports", []byte("not a dir"), 0600)
+			app.Config.Output.Targets = []*OutputTarget{
+				{Format: ResultFormatJson, Path: "reports/stylist.json"},
+			}
+
+			err := PrintResults(app.IO, app.Config, results, &RunInfo{})
+			assert.ErrorContains(t, err, "output json=reports/stylist.json")
+		})
+	})
+}
+
+func TestFileIOStream(t *testing.T) {
+	testutil.InTempDir(t, func(dir string) {
+		file, err := os.Create("out.txt")
+		require.NoError(t, err)
+		defer file.Close()
+
+		stream := &fileIOStream{File: file}
+		assert.Equal(t, "", stream.String())
+
+		_, err = fmt.Fprint(stream, "one\ntwo\n")
+		require.NoError(t, err)
+		assert.Equal(t, "one\ntwo\n", stream.String())
+		assert.Equal(t, []string{"one", "two"}, stream.Lines())
+
+		// Reading does not depend on (or move) the write offset.
+		_, err = fmt.Fprint(stream, "three\n")
+		require.NoError(t, err)
+		assert.Equal(t, "one\ntwo\nthree\n", stream.String())
+		testutil.AssertFilePath(t, "out.txt", "one\ntwo\nthree\n")
+	})
+}
@@ -24,6 +24,15 @@ func NewPipeline(processors []*Processor, excludes []string) *Pipeline {
 type Pipeline struct {
 	processors []*Processor
 	excludes   []string
+	runInfo    *RunInfo
+}
+
+// RunInfo returns details about the most recent run of the pipeline.
+func (p *Pipeline) RunInfo() *RunInfo {
+	if p.runInfo == nil {
+		return &RunInfo{}
+	}
+	return p.runInfo
 }
 
 // Match returns all processors that match the given path specs.
@@ -110,14 +119,57 @@ func (p *Pipeline) Fix(
 	return p.execute(ctx, basePath, pathSpecs, CommandTypeFix)
 }
 
+// ApplySuggestions executes the check command for each processor in the pipeline
+// and applies any fixes suggested in the results.
+// Returns the results that were fixed.
+func (p *Pipeline) ApplySuggestions(
+	ctx context.Context, basePath string, pathSpecs []string,
+) ([]*Result, error) {
+	results, _, err := p.run(ctx, basePath, pathSpecs, CommandTypeCheck)
+	if err != nil {
+		return nil, err
+	}
+	results, err = transformResults(ctx, results, p.resultTransformers(nil))
+	if err != nil {
+		return nil, err
+	}
+	// Apply the fixes before the output transformers so that
+	// display options (i.e. `--severity`) don't determine what gets fixed.
+	results, err = NewFixApplier().Apply(results)
+	if err != nil {
+		return nil, err
+	}
+	return transformResults(ctx, results, outputTransformers())
+}
+
 func (p *Pipeline) execute(
 	ctx context.Context, basePath string, pathSpecs []string, ct CommandType,
 ) ([]*Result, error) {
+	results, run, err := p.run(ctx, basePath, pathSpecs, ct)
+	if err != nil {
+		return nil, err
+	}
+	// Fix commands don't report results, so every suppression would
+	// appear to be unused.
+	var checked map[string][]string
+	if ct == CommandTypeCheck {
+		checked = run.Paths
+	}
+	transformers := append(p.resultTransformers(checked), outputTransformers()...)
+	return transformResults(ctx, results, transformers)
+}
+
+// Executes the processors matching pathSpecs and returns the untransformed
+// results along with what each processor ran against.
+func (p *Pipeline) run(
+	ctx context.Context, basePath string, pathSpecs []string, ct CommandType,
+) ([]*Result, *RunInfo, error) {
 	// Match the pathSpecs.
 	matches, err := p.Match(ctx, basePath, pathSpecs)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	matches = skipStdinBuffer(ctx, basePath, matches, ct)
 
 	// Setup an errgroup w/ the correct level of parallelism.
 	// Fix commands mutate files, so each processor needs to run serially.
@@ -147,24 +199,100 @@ func (p *Pipeline) execute(
 
 	err = group.Wait()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	// Record what each processor ran against.
+	runInfo := &RunInfo{Paths: map[string][]string{}}
+	for _, processor := range p.processors {
+		if processor.implements(ct) {
+			runInfo.Paths[processor.Name] = []string{}
+		}
+	}
+	for _, match := range matches {
+		if match.Processor.implements(ct) {
+			name := match.Processor.Name
+			runInfo.Paths[name] = append(runInfo.Paths[name], match.Paths...)
+		}
+	}
+	p.runInfo = runInfo
+
+	return results, runInfo, nil
+}
+
+// Removes the stdin buffer path from processors that can't be given
+// the buffer. Commands with InputTypeNone check the files on disk,
+// so their results wouldn't match the buffer contents used for
+// suppressions and context lines.
+func skipStdinBuffer(
+	ctx context.Context, basePath string, matches []PipelineMatch, ct CommandType,
+) []PipelineMatch {
+	buf := StdinBufferFrom(ctx)
+	if buf == nil || ct != CommandTypeCheck {
+		return matches
+	}
+
+	bufPath := NormalizePath(basePath, buf.Path)
+	filtered := []PipelineMatch{}
+	for _, match := range matches {
+		cmd := match.Processor.CheckCommand
+		if cmd == nil || cmd.InputType != InputTypeNone {
+			filtered = append(filtered, match)
+			continue
+		}
+		paths := []string{}
+		for _, path := range match.Paths {
+			if NormalizePath(basePath, path) != bufPath {
+				paths = append(paths, path)
+			}
+		}
+		if len(paths) < len(match.Paths) {
+			AppLogger(ctx).Warnf(
+				"Skipping %s for %s: it can not check the contents of stdin",
+				match.Processor.Name, buf.Path,
+			)
+		}
+		if len(paths) > 0 {
+			filtered = append(filtered, PipelineMatch{
+				Paths:     paths,
+				Processor: match.Processor,
+			})
+		}
+	}
+	return filtered
+}
+
+// Returns the transformers that determine which results were found.
+// See SuppressResults for checked.
+func (p *Pipeline) resultTransformers(checked map[string][]string) []ResultsTransformer {
+	return []ResultsTransformer{
+		SuppressResults(checked),
+		OverrideRules(p.processors),
+		ResolveRuleURIs(p.processors),
 	}
+}
 
-	// Run the results through some post-processing steps.
-	transformers := []ResultsTransformer{
+// Returns the transformers that determine how results are displayed.
+func outputTransformers() []ResultsTransformer {
+	return []ResultsTransformer{
 		FilterResults,
 		AdjustPath,
 		SortResults,
 		EnsureContextLines,
 	}
+}
+
+// Runs the results through each transformer in order.
+func transformResults(
+	ctx context.Context, results []*Result, transformers []ResultsTransformer,
+) ([]*Result, error) {
+	var err error
 	for _, transformer := range transformers {
 		results, err = transformer(ctx, results)
 		if err != nil {
 			return nil, err
 		}
 	}
-
-	// Return the transformed results.
 	return results, nil
 }
 
@@ -189,6 +317,95 @@ func FilterResults(ctx context.Context, results []*Result) ([]*Result, error) {
 	return filtered, nil
 }
 
+// SuppressResults returns a transformer that removes any results matching
+// inline suppression comments.
+//
+// When configured, unused suppressions are reported as new results.
+// checked maps each processor name to the paths it checked, and only
+// suppressions in those paths targeting processors that checked them are
+// reported (see Suppression.Checked). Nothing is reported when it is nil.
+func SuppressResults(checked map[string][]string) ResultsTransformer {
+	return func(ctx context.Context, results []*Result) ([]*Result, error) {
+		config := AppConfig(ctx)
+		logger := AppLogger(ctx)
+		scanner := NewSuppressionScanner(StdinBufferFrom(ctx))
+
+		// Only scan the files we need to.
+		scanPaths := NewPathSet()
+		for _, r := range results {
+			if r.Location.Path != "" {
+				scanPaths.Add(r.Location.Path)
+			}
+		}
+		reportUnused := config.Output.ReportUnusedSuppressions && checked != nil
+		checkedBy := map[string]mapset.Set[string]{}
+		if reportUnused {
+			for name, paths := range checked {
+				for _, path := range paths {
+					if checkedBy[path] == nil {
+						checkedBy[path] = mapset.NewThreadUnsafeSet[string]()
+					}
+					checkedBy[path].Add(name)
+				}
+				scanPaths.Append(paths...)
+			}
+		}
+
+		suppressionsByPath := map[string][]*Suppression{}
+		for _, path := range scanPaths.ToSlice() {
+			suppressions, err := scanner.Scan(path)
+			if err != nil {
+				// Not every result path is a readable file (directories, etc).
+				logger.Debugf("Unable to scan for suppressions: %v", err)
+				continue
+			}
+			suppressionsByPath[path] = suppressions
+		}
+
+		filtered := []*Result{}
+		for _, r := range results {
+			suppressed := false
+			for _, s := range suppressionsByPath[r.Location.Path] {
+				if s.Matches(r) {
+					s.used = true
+					suppressed = true
+				}
+			}
+			if !suppressed {
+				filtered = append(filtered, r)
+			}
+		}
+
+		if reportUnused {
+			for _, path := range scanPaths.ToSlice() {
+				for _, s := range suppressionsByPath[path] {
+					if !s.used && checkedBy[path] != nil && s.Checked(checkedBy[path]) {
+						filtered = append(filtered, newUnusedSuppressionResult(s))
+					}
+				}
+			}
+		}
+
+		return filtered, nil
+	}
+}
+
+func newUnusedSuppressionResult(s *Suppression) *Result {
+	return &Result{
+		Source: "stylist",
+		Level:  ResultLevelWarning,
+		Location: ResultLocation{
+			Path:      s.Path,
+			StartLine: s.Line,
+		},
+		Rule: ResultRule{
+			ID:          "unused-suppression",
+			Name:        "unused-suppression",
+			Description: fmt.Sprintf("Unused suppression: %s", s.Text),
+		},
+	}
+}
+
 func SortResults(ctx context.Context, results []*Result) ([]*Result, error) {
 	config := AppConfig(ctx)
 
@@ -211,7 +428,7 @@ func SortResults(ctx context.Context, results []*Result) ([]*Result, error) {
 func EnsureContextLines(ctx context.Context, results []*Result) ([]*Result, error) {
 	config := AppConfig(ctx)
 
-	loader := NewContextLineLoader()
+	loader := NewContextLineLoader(StdinBufferFrom(ctx))
 	analyzer := NewContextLineAnalyzer()
 
 	// Load context lines concurrently (loader uses a mutex wrapped cache).
@@ -226,6 +443,14 @@ func EnsureContextLines(ctx context.Context, results []*Result) ([]*Result, erro
 				}
 				if result.ContextLines == nil {
 					result.ContextLines = lines
+					if config.Output.ContextBefore > 0 || config.Output.ContextAfter > 0 {
+						result.ContextBefore, result.ContextAfter, err = loader.LoadSurrounding(
+							result.Location, config.Output.ContextBefore, config.Output.ContextAfter,
+						)
+						if err != nil {
+							return err
+						}
+					}
 				}
 				if result.ContextLang == "" {
 					result.ContextLang = analyzer.DetectLanguage(result.Location.Path, lines)
@@ -233,6 +458,8 @@ func EnsureContextLines(ctx context.Context, results []*Result) ([]*Result, erro
 			} else {
 				result.ContextLines = nil
 				result.ContextLang = ""
+				result.ContextBefore = nil
+				result.ContextAfter = nil
 			}
 			return nil
 		})
@@ -257,6 +484,21 @@ func AdjustPath(ctx context.Context, results []*Result) ([]*Result, error) {
 		if err != nil {
 			return nil, err
 		}
+		for _, fix := range result.Fixes {
+			fix.Location.Path, err = adjuster.Convert(fix.Location.Path)
+			if err != nil {
+				return nil, err
+			}
+		}
+		for _, loc := range result.SecondaryLocations() {
+			if loc.Path == "" {
+				continue // i.e. a logical location
+			}
+			loc.Path, err = adjuster.Convert(loc.Path)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	return results, nil
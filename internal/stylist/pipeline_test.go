@@ -9,7 +9,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/twelvelabs/termite/render"
 	"github.com/twelvelabs/termite/run"
+	"github.com/twelvelabs/termite/testutil"
 )
 
 func TestNewPipeline(t *testing.T) {
@@ -233,6 +235,7 @@ func TestEnsureContextLines(t *testing.T) {
 	tests := []struct {
 		desc     string
 		config   *OutputConfig
+		stdin    *StdinBuffer
 		results  []*Result
 		expected []*Result
 		err      string
@@ -300,6 +303,85 @@ func TestEnsureContextLines(t *testing.T) {
 			err: "",
 		},
 
+		{
+			desc: "loads context from the stdin buffer",
+			config: &OutputConfig{
+				ShowContext:   true,
+				ContextBefore: 1,
+				ContextAfter:  1,
+			},
+			stdin: &StdinBuffer{
+				Path:    "testdata/txt/aaa.txt",
+				Content: []byte("one\ntwo\nthree\n"),
+			},
+			results: []*Result{
+				{
+					Location: ResultLocation{
+						Path:      "testdata/txt/aaa.txt",
+						StartLine: 2,
+					},
+				},
+			},
+			expected: []*Result{
+				{
+					Location: ResultLocation{
+						Path:      "testdata/txt/aaa.txt",
+						StartLine: 2,
+					},
+					ContextLang:   "plaintext",
+					ContextLines:  []string{"two"},
+					ContextBefore: []string{"one"},
+					ContextAfter:  []string{"three"},
+				},
+			},
+			err: "",
+		},
+		{
+			desc: "loads surrounding lines when configured",
+			config: &OutputConfig{
+				ShowContext:   true,
+				ContextBefore: 1,
+				ContextAfter:  2,
+			},
+			results: []*Result{
+				{
+					Location: ResultLocation{
+						Path:      "testdata/example.txt",
+						StartLine: 2,
+					},
+				},
+				{
+					Location: ResultLocation{
+						Path:      "testdata/example.txt",
+						StartLine: 2,
+					},
+					ContextLang:  "diff",
+					ContextLines: []string{"+two"},
+				},
+			},
+			expected: []*Result{
+				{
+					Location: ResultLocation{
+						Path:      "testdata/example.txt",
+						StartLine: 2,
+					},
+					ContextLang:   "plaintext",
+					ContextLines:  []string{"two"},
+					ContextBefore: []string{"one"},
+					ContextAfter:  []string{"three"},
+				},
+				{
+					Location: ResultLocation{
+						Path:      "testdata/example.txt",
+						StartLine: 2,
+					},
+					ContextLang:  "diff",
+					ContextLines: []string{"+two"},
+				},
+			},
+			err: "",
+		},
+
 		{
 			desc: "strips context when disabled via config",
 			config: &OutputConfig{
@@ -379,6 +461,9 @@ func TestEnsureContextLines(t *testing.T) {
 				app.Config.Output = *tt.config
 			}
 			ctx := app.InitContext(context.Background())
+			if tt.stdin != nil {
+				ctx = WithStdinBuffer(ctx, tt.stdin)
+			}
 
 			actual, err := EnsureContextLines(ctx, tt.results)
 
@@ -393,6 +478,169 @@ func TestEnsureContextLines(t *testing.T) {
 	}
 }
 
+func TestSuppressResults(t *testing.T) {
+	examplePath := "testdata/suppressions/example.go"
+	ignoredPath := "testdata/suppressions/ignored.go"
+	newResult := func(path string, line int, source string, ruleID string) *Result {
+		return &Result{
+			Source:   source,
+			Location: ResultLocation{Path: path, StartLine: line},
+			Rule:     ResultRule{ID: ruleID},
+		}
+	}
+
+	tests := []struct {
+		desc     string
+		config   *OutputConfig
+		stdin    *StdinBuffer
+		checked  map[string][]string
+		results  []*Result
+		expected []*Result
+		err      string
+	}{
+		{
+			desc: "removes suppressed results",
+			results: []*Result{
+				newResult(examplePath, 3, "linter", "rule-a"),
+				newResult(examplePath, 3, "linter", "rule-b"),
+				newResult(examplePath, 6, "linter", "rule-b"),
+				newResult(examplePath, 6, "other", "rule-b"),
+				newResult(examplePath, 8, "other", "rule-c"),
+				newResult(examplePath, 13, "linter", "rule-a"),
+				newResult(ignoredPath, 2, "linter", "rule-b"),
+				newResult(ignoredPath, 2, "linter", "rule-c"),
+				newResult("", 0, "linter", "rule-a"),
+			},
+			expected: []*Result{
+				newResult(examplePath, 3, "linter", "rule-b"),
+				newResult(examplePath, 6, "other", "rule-b"),
+				newResult(examplePath, 13, "linter", "rule-a"),
+				newResult(ignoredPath, 2, "linter", "rule-c"),
+				newResult("", 0, "linter", "rule-a"),
+			},
+		},
+		{
+			desc: "scans the stdin buffer in place of the file",
+			stdin: &StdinBuffer{
+				Path:    examplePath,
+				Content: []byte("package example\n\n// stylist:ignore[linter/rule-b]\n"),
+			},
+			results: []*Result{
+				newResult(examplePath, 3, "linter", "rule-a"),
+				newResult(examplePath, 3, "linter", "rule-b"),
+				newResult(examplePath, 8, "other", "rule-c"),
+			},
+			expected: []*Result{
+				newResult(examplePath, 3, "linter", "rule-a"),
+				newResult(examplePath, 8, "other", "rule-c"),
+			},
+		},
+		{
+			desc: "reports unused suppressions when configured",
+			config: &OutputConfig{
+				ReportUnusedSuppressions: true,
+			},
+			checked: map[string][]string{
+				"linter": {examplePath, ignoredPath},
+				"other":  {examplePath, ignoredPath},
+			},
+			results: []*Result{
+				newResult(examplePath, 3, "linter", "rule-a"),
+				newResult(examplePath, 6, "linter", "rule-b"),
+				newResult(examplePath, 8, "other", "rule-c"),
+			},
+			expected: []*Result{
+				{
+					Source:   "stylist",
+					Level:    ResultLevelWarning,
+					Location: ResultLocation{Path: examplePath, StartLine: 10},
+					Rule: ResultRule{
+						ID:          "unused-suppression",
+						Name:        "unused-suppression",
+						Description: "Unused suppression: stylist:ignore[linter/rule-a, other]",
+					},
+				},
+				{
+					Source:   "stylist",
+					Level:    ResultLevelWarning,
+					Location: ResultLocation{Path: ignoredPath, StartLine: 1},
+					Rule: ResultRule{
+						ID:          "unused-suppression",
+						Name:        "unused-suppression",
+						Description: "Unused suppression: stylist:ignore-file[linter/rule-b]",
+					},
+				},
+				{
+					Source:   "stylist",
+					Level:    ResultLevelWarning,
+					Location: ResultLocation{Path: examplePath, StartLine: 18},
+					Rule: ResultRule{
+						ID:          "unused-suppression",
+						Name:        "unused-suppression",
+						Description: "Unused suppression: stylist:ignore[linter/rule-c]",
+					},
+				},
+			},
+		},
+		{
+			desc: "only reports unused suppressions for processors that checked the path",
+			config: &OutputConfig{
+				ReportUnusedSuppressions: true,
+			},
+			checked: map[string][]string{
+				"other": {examplePath},
+			},
+			results: []*Result{},
+			expected: []*Result{
+				{
+					Source:   "stylist",
+					Level:    ResultLevelWarning,
+					Location: ResultLocation{Path: examplePath, StartLine: 8},
+					Rule: ResultRule{
+						ID:          "unused-suppression",
+						Name:        "unused-suppression",
+						Description: "Unused suppression: stylist:ignore",
+					},
+				},
+			},
+		},
+		{
+			desc: "does not report unused suppressions when nothing was checked",
+			config: &OutputConfig{
+				ReportUnusedSuppressions: true,
+			},
+			checked: nil,
+			results: []*Result{
+				newResult(examplePath, 3, "linter", "rule-a"),
+			},
+			expected: []*Result{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			app := NewTestApp()
+			if tt.config != nil {
+				app.Config.Output = *tt.config
+			}
+			ctx := app.InitContext(context.Background())
+			if tt.stdin != nil {
+				ctx = WithStdinBuffer(ctx, tt.stdin)
+			}
+
+			transformer := SuppressResults(tt.checked)
+			actual, err := transformer(ctx, tt.results)
+
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.err)
+			}
+
+			require.ElementsMatch(t, tt.expected, actual)
+		})
+	}
+}
+
 func TestSortResults(t *testing.T) {
 	tests := []struct {
 		desc     string
@@ -621,3 +869,184 @@ func TestAdjustPath(t *testing.T) {
 		})
 	}
 }
+
+func TestPipeline_ApplySuggestions(t *testing.T) {
+	testutil.InTempDir(t, func(dir string) {
+		testutil.WriteFile(t, "example.txt", []byte("one\ntwo\n"), 0600)
+
+		app := NewTestApp()
+		defer app.CmdClient.VerifyStubs(t)
+		app.CmdClient.RegisterStub(
+			run.MatchString("pretend-linter example.txt"),
+			run.StdoutResponse([]byte(`{"message": "Uppercase", "location": {"path": "example.txt", "range": {"start": {"line": 2, "column": 1}}}, "severity": "INFO", "suggestions": [{"range": {"start": {"line": 2, "column": 1}, "end": {"line": 2, "column": 4}}, "text": "TWO"}]}`), 1),
+		)
+		// Only errors are displayed, but suggestions for lower
+		// severity results should still be applied.
+		app.Config.Output.Severity = []string{"error"}
+
+		ctx := app.InitContext(context.Background())
+
+		pipeline := NewPipeline([]*Processor{
+			{
+				Includes: []string{"*.txt"},
+				CheckCommand: &Command{
+					Template:     "pretend-linter",
+					InputType:    InputTypeVariadic,
+					OutputType:   OutputTypeStdout,
+					OutputFormat: OutputFormatRdjsonl,
+				},
+			},
+		}, []string{})
+		actual, err := pipeline.ApplySuggestions(ctx, "", []string{"."})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*Result{}, actual)
+		testutil.AssertFilePath(t, "example.txt", "one\nTWO\n")
+	})
+}
+
+func TestPipeline_ReportUnusedSuppressions(t *testing.T) {
+	testutil.InTempDir(t, func(dir string) {
+		testutil.WriteFile(t, "example.sh", []byte("echo $1 # stylist:ignore[linter]\n"), 0600)
+
+		app := NewTestApp()
+		defer app.CmdClient.VerifyStubs(t)
+		app.CmdClient.RegisterStub(
+			run.MatchString("pretend-linter example.sh"),
+			run.StdoutResponse([]byte(""), 0),
+		)
+		app.CmdClient.RegisterStub(
+			run.MatchString("pretend-linter --fix example.sh"),
+			run.StdoutResponse([]byte(""), 0),
+		)
+		app.Config.Output.ReportUnusedSuppressions = true
+
+		ctx := app.InitContext(context.Background())
+
+		pipeline := NewPipeline([]*Processor{
+			{
+				Name:     "linter",
+				Includes: []string{"*.sh"},
+				CheckCommand: &Command{
+					Template:     "pretend-linter",
+					InputType:    InputTypeVariadic,
+					OutputFormat: OutputFormatNone,
+				},
+				FixCommand: &Command{
+					Template:     "pretend-linter --fix",
+					InputType:    InputTypeVariadic,
+					OutputFormat: OutputFormatNone,
+				},
+			},
+		}, []string{})
+
+		results, err := pipeline.Check(ctx, "", []string{"."})
+		assert.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, "unused-suppression", results[0].Rule.ID)
+
+		// Fix commands don't report results, so nothing is unused.
+		results, err = pipeline.Fix(ctx, "", []string{"."})
+		assert.NoError(t, err)
+		assert.Empty(t, results)
+	})
+}
+
+func TestPipeline_SkipsProcessorsWithoutStdinSupport(t *testing.T) {
+	app := NewTestApp()
+	defer app.CmdClient.VerifyStubs(t)
+	app.CmdClient.RegisterStub(
+		run.MatchString("pretend-linter --stdin-filename=testdata/txt/aaa.txt"),
+		run.StdoutResponse([]byte(""), 0),
+	)
+
+	ctx := app.InitContext(context.Background())
+	ctx = WithStdinBuffer(ctx, &StdinBuffer{
+		Path:    "testdata/txt/aaa.txt",
+		Content: []byte("unsaved content\n"),
+	})
+
+	pipeline := NewPipeline([]*Processor{
+		{
+			Name:     "linter",
+			Includes: []string{"testdata/txt/*.txt"},
+			CheckCommand: &Command{
+				Template:      "pretend-linter",
+				InputType:     InputTypeStdin,
+				OutputFormat:  OutputFormatNone,
+				StdinFilename: render.MustCompile("--stdin-filename={{ .Path }}"),
+			},
+		},
+		{
+			// Would check the file on disk rather than the buffer.
+			Name:     "project-linter",
+			Includes: []string{"testdata/txt/*.txt"},
+			CheckCommand: &Command{
+				Template:     "pretend-project-linter",
+				InputType:    InputTypeNone,
+				OutputFormat: OutputFormatNone,
+			},
+		},
+	}, []string{})
+
+	_, err := pipeline.Check(ctx, "", []string{"testdata/txt/aaa.txt"})
+	require.NoError(t, err)
+
+	assert.Equal(t, &RunInfo{
+		Paths: map[string][]string{
+			"linter":         {"testdata/txt/aaa.txt"},
+			"project-linter": {},
+		},
+	}, pipeline.RunInfo())
+}
+
+func TestPipeline_RunInfo(t *testing.T) {
+	app := NewTestApp()
+	defer app.CmdClient.VerifyStubs(t)
+	app.CmdClient.RegisterStub(
+		run.MatchString("pretend-linter testdata/txt/aaa.txt testdata/txt/bbb.txt testdata/txt/ccc.txt"),
+		run.StdoutResponse([]byte(""), 0),
+	)
+
+	ctx := app.InitContext(context.Background())
+
+	pipeline := NewPipeline([]*Processor{
+		{
+			Name:     "linter",
+			Includes: []string{"testdata/txt/*.txt"},
+			CheckCommand: &Command{
+				Template:     "pretend-linter",
+				InputType:    InputTypeVariadic,
+				OutputType:   OutputTypeStdout,
+				OutputFormat: OutputFormatNone,
+			},
+		},
+		{
+			Name:     "unmatched",
+			Includes: []string{"**/*.nope"},
+			CheckCommand: &Command{
+				Template: "pretend-linter",
+			},
+		},
+		{
+			Name:       "fixer",
+			Includes:   []string{"testdata/txt/*.txt"},
+			FixCommand: &Command{Template: "pretend-fixer"},
+		},
+	}, []string{})
+	assert.Equal(t, &RunInfo{}, pipeline.RunInfo())
+
+	_, err := pipeline.Check(ctx, "", []string{"testdata/txt"})
+	require.NoError(t, err)
+
+	assert.Equal(t, &RunInfo{
+		Paths: map[string][]string{
+			"linter": {
+				"testdata/txt/aaa.txt",
+				"testdata/txt/bbb.txt",
+				"testdata/txt/ccc.txt",
+			},
+			"unmatched": {},
+		},
+	}, pipeline.RunInfo())
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/twelvelabs/termite/render"
 )
 
 func TestNewPresetStore(t *testing.T) {
@@ -117,3 +118,136 @@ func TestResolvePresets_WhenUnknownPreset(t *testing.T) {
 	require.ErrorContains(t, err, "unknown preset")
 	require.Equal(t, 0, len(processors))
 }
+
+func TestPresetStore_RuleURIs(t *testing.T) {
+	store, err := NewPresetStore()
+	require.NoError(t, err)
+
+	tests := []struct {
+		preset string
+		ruleID string
+		want   string
+	}{
+		{
+			preset: "hadolint",
+			ruleID: "DL3008",
+			want:   "https://github.com/hadolint/hadolint/wiki/DL3008",
+		},
+		{
+			preset: "hadolint",
+			ruleID: "SC2086",
+			want:   "https://www.shellcheck.net/wiki/SC2086",
+		},
+		{
+			preset: "markdownlint",
+			ruleID: "MD013",
+			want:   "https://github.com/DavidAnson/markdownlint/blob/main/doc/md013.md",
+		},
+		{
+			preset: "shellcheck",
+			ruleID: "SC2086",
+			want:   "https://www.shellcheck.net/wiki/SC2086",
+		},
+		{
+			preset: "tflint",
+			ruleID: "terraform_unused_declarations",
+			want:   "https://github.com/terraform-linters/tflint-ruleset-terraform/blob/main/docs/rules/terraform_unused_declarations.md",
+		},
+		{
+			// URIs come from the result mapping.
+			preset: "golangci-lint",
+			ruleID: "errcheck",
+			want:   "",
+		},
+		{
+			// No per-rule documentation exists.
+			preset: "gitleaks",
+			ruleID: "aws-access-token",
+			want:   "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.preset+"/"+tt.ruleID, func(t *testing.T) {
+			preset, err := store.Get(tt.preset)
+			require.NoError(t, err)
+
+			uri, err := preset.RuleURIFor(ResultRule{ID: tt.ruleID})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, uri)
+		})
+	}
+}
+
+func TestPresetStore_TFLintMappings(t *testing.T) {
+	store, err := NewPresetStore()
+	require.NoError(t, err)
+	preset, err := store.Get("tflint")
+	require.NoError(t, err)
+
+	parse := func(p *Processor) []*Result {
+		results := []*Result{}
+		err := ParseMappings(
+			NewOutputParser(p.CheckCommand.OutputFormat),
+			CommandOutput{Content: mustOpenFile("testdata/output/tflint.json")},
+			p.CheckCommand.ResultMappings,
+			func(r *Result) error {
+				results = append(results, r)
+				return nil
+			},
+		)
+		require.NoError(t, err)
+		return results
+	}
+
+	// Issues and errors are each parsed using their own mapping.
+	assert.Equal(t, []*Result{
+		{
+			Level: ResultLevelWarning,
+			Location: ResultLocation{
+				Path:        "main.tf",
+				StartLine:   1,
+				StartColumn: 1,
+				EndLine:     1,
+				EndColumn:   18,
+			},
+			Rule: ResultRule{
+				ID:          "terraform_unused_declarations",
+				Name:        "terraform_unused_declarations",
+				Description: `variable "region" is declared but not used`,
+				URI:         "https://github.com/terraform-linters/tflint-ruleset-terraform/blob/v0.5.0/docs/rules/terraform_unused_declarations.md",
+			},
+		},
+		{
+			Level: ResultLevelError,
+			Location: ResultLocation{
+				Path:        "broken.tf",
+				StartLine:   3,
+				StartColumn: 1,
+				EndLine:     3,
+				EndColumn:   2,
+			},
+			Rule: ResultRule{
+				Description: "Argument or block definition required",
+			},
+		},
+	}, parse(preset))
+
+	// A single mapping in config overrides the field in each of them.
+	processors, err := ResolvePresets([]*Processor{
+		{
+			Preset: "tflint",
+			CheckCommand: &Command{
+				ResultMappings: ResultMappings{
+					{Level: render.MustCompile("info")},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	results := parse(processors[0])
+	require.Len(t, results, 2)
+	for _, r := range results {
+		assert.Equal(t, ResultLevelInfo, r.Level)
+		assert.NotEmpty(t, r.Location.Path)
+	}
+}
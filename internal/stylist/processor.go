@@ -3,20 +3,39 @@ package stylist
 import (
 	"context"
 	"fmt"
+	"maps"
+	"reflect"
+	"slices"
 	"strings"
 
 	"dario.cat/mergo"
 	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/twelvelabs/termite/render"
 )
 
 type Processor struct {
-	Preset       string   `yaml:"preset,omitempty"`
-	Name         string   `yaml:"name,omitempty"`
-	Tags         []string `yaml:"tags,omitempty"`
-	Includes     []string `yaml:"includes,omitempty"`
-	Excludes     []string `yaml:"excludes,omitempty"`
-	CheckCommand *Command `yaml:"check,omitempty"`
-	FixCommand   *Command `yaml:"fix,omitempty"`
+	Preset         string            `yaml:"preset,omitempty"`
+	Name           string            `yaml:"name,omitempty"`
+	Tags           []string          `yaml:"tags,omitempty"`
+	Includes       []string          `yaml:"includes,omitempty"`
+	Excludes       []string          `yaml:"excludes,omitempty"`
+	Rules          map[string]string `yaml:"rules,omitempty"`
+	RuleURI        *render.Template  `yaml:"rule_uri,omitempty"`
+	ExplainCommand *render.Template  `yaml:"explain_command,omitempty"`
+	CheckCommand   *Command          `yaml:"check,omitempty"`
+	FixCommand     *Command          `yaml:"fix,omitempty"`
+}
+
+// Returns true if the processor has anything to execute for ct.
+func (p *Processor) implements(ct CommandType) bool {
+	switch ct {
+	case CommandTypeCheck:
+		return p.CheckCommand != nil
+	case CommandTypeFix:
+		return p.FixCommand != nil ||
+			(p.CheckCommand != nil && p.CheckCommand.OutputFormat == OutputFormatDiff)
+	}
+	return false
 }
 
 // Execute runs the given command for paths.
@@ -30,6 +49,11 @@ func (p *Processor) Execute(
 		cmd = p.CheckCommand
 	case CommandTypeFix:
 		cmd = p.FixCommand
+		if cmd == nil && p.CheckCommand != nil && p.CheckCommand.OutputFormat == OutputFormatDiff {
+			// The check command already knows how to fix things,
+			// so just apply the diff it generates.
+			return p.applyCheckDiff(ctx, basePath, paths)
+		}
 	}
 
 	if cmd == nil {
@@ -41,17 +65,133 @@ func (p *Processor) Execute(
 	return cmd.Execute(ctx, p.Name, basePath, paths)
 }
 
+// Runs the check command and applies the fixes parsed from the diff output.
+// Returns any results that could not be fixed.
+func (p *Processor) applyCheckDiff(
+	ctx context.Context, basePath string, paths []string,
+) ([]*Result, error) {
+	results, err := p.CheckCommand.Execute(ctx, p.Name, basePath, paths)
+	if err != nil {
+		return nil, err
+	}
+
+	fixed, err := NewFixApplier().Apply(results)
+	if err != nil {
+		return nil, err
+	}
+	fixedSet := mapset.NewSet(fixed...)
+
+	unfixed := []*Result{}
+	for _, r := range results {
+		if !fixedSet.Contains(r) {
+			unfixed = append(unfixed, r)
+		}
+	}
+	return unfixed, nil
+}
+
 // Merge merges the receiver and arguments and returns a new processor
 // Only exported fields are merged.
+// Result mappings are merged field by field (see mergeTransformer).
 func (p *Processor) Merge(others ...*Processor) *Processor {
-	dst := &Processor{}
-	_ = mergo.Merge(dst, p)
+	transformers := mergo.WithTransformers(mergeTransformer{})
+	// Copy the receiver so that merging doesn't modify it (or a preset)
+	// through the pointers and maps it shares with dst.
+	dst := p.clone()
 	for _, other := range others {
-		_ = mergo.Merge(dst, other, mergo.WithOverride)
+		_ = mergo.Merge(dst, other, mergo.WithOverride, transformers)
+	}
+	return dst
+}
+
+// Returns a copy of the processor that shares no mutable state with it.
+func (p *Processor) clone() *Processor {
+	dst := *p
+	dst.Tags = slices.Clone(p.Tags)
+	dst.Includes = slices.Clone(p.Includes)
+	dst.Excludes = slices.Clone(p.Excludes)
+	dst.Rules = maps.Clone(p.Rules)
+	dst.CheckCommand = p.CheckCommand.clone()
+	dst.FixCommand = p.FixCommand.clone()
+	return &dst
+}
+
+// mergeTransformer customizes how mergo merges processors:
+//   - templates are replaced rather than merged (mergo would copy src into
+//     the template dst points to, modifying whichever preset it came from)
+//   - result mappings are merged field by field, so processors can override
+//     individual fields of a preset's mappings: a single source mapping is
+//     merged into every destination mapping, lists of the same length are
+//     merged by position, and otherwise the source list replaces the
+//     destination list
+type mergeTransformer struct{}
+
+func (t mergeTransformer) Transformer(typ reflect.Type) func(dst, src reflect.Value) error {
+	switch typ {
+	case reflect.TypeOf(&render.Template{}):
+		return func(dst, src reflect.Value) error {
+			if !src.IsNil() {
+				dst.Set(src)
+			}
+			return nil
+		}
+	case reflect.TypeOf(ResultMappings{}):
+		return mergeResultMappings
+	default:
+		return nil
 	}
+}
+
+// Merges src result mappings into dst (see mergeTransformer).
+func mergeResultMappings(dst, src reflect.Value) error {
+	dstMappings := dst.Interface().(ResultMappings)
+	srcMappings := src.Interface().(ResultMappings)
+
+	if len(srcMappings) == 0 {
+		return nil
+	}
+	if len(srcMappings) != 1 && len(srcMappings) != len(dstMappings) {
+		dst.Set(reflect.ValueOf(append(ResultMappings{}, srcMappings...)))
+		return nil
+	}
+
+	merged := append(ResultMappings{}, dstMappings...)
+	if len(merged) == 0 {
+		merged = make(ResultMappings, 1)
+	}
+	for idx := range merged {
+		mapping := srcMappings[0]
+		if len(srcMappings) > 1 {
+			mapping = srcMappings[idx]
+		}
+		merged[idx] = mergeResultMapping(merged[idx], mapping)
+	}
+	dst.Set(reflect.ValueOf(merged))
+	return nil
+}
+
+// Returns dst with the fields set in src.
+func mergeResultMapping(dst, src ResultMapping) ResultMapping {
+	if dst.Fix != nil && src.Fix != nil {
+		fix := *dst.Fix
+		overrideFields(&fix, src.Fix)
+		src.Fix = &fix
+	}
+	overrideFields(&dst, &src)
 	return dst
 }
 
+// Sets each non-zero field of the struct src points to on dst.
+func overrideFields[T any](dst, src *T) {
+	dstValue := reflect.ValueOf(dst).Elem()
+	srcValue := reflect.ValueOf(src).Elem()
+	for i := range srcValue.NumField() {
+		if field := srcValue.Field(i); !field.IsZero() {
+			dstValue.Field(i).Set(field)
+		}
+	}
+}
+
 // ProcessorFilter filters processors by name and/or tag.
 type ProcessorFilter struct {
 	Names []string
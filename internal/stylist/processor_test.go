@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/twelvelabs/termite/render"
+	"github.com/twelvelabs/termite/run"
+	"github.com/twelvelabs/termite/testutil"
 )
 
 func TestProcessor_Execute_WhenNoCommandDefined(t *testing.T) {
@@ -24,6 +26,46 @@ func TestProcessor_Execute_WhenNoCommandDefined(t *testing.T) {
 	assert.Nil(t, results)
 }
 
+func TestProcessor_Execute_WhenFixingWithCheckDiff(t *testing.T) {
+	app := NewTestApp()
+	defer app.CmdClient.VerifyStubs(t)
+	ctx := app.InitContext(context.Background())
+
+	app.CmdClient.RegisterStub(
+		run.MatchString("pretend-fmt -d example.txt"),
+		run.StdoutResponse([]byte(
+			"--- example.txt.orig\n"+
+				"+++ example.txt\n"+
+				"@@ -1,3 +1,3 @@\n"+
+				" one\n"+
+				"-  two\n"+
+				"+two\n"+
+				" three\n",
+		), 1),
+	)
+
+	processor := &Processor{
+		Name: "pretend-fmt",
+		CheckCommand: &Command{
+			Template:     "pretend-fmt -d",
+			InputType:    InputTypeVariadic,
+			OutputType:   OutputTypeStdout,
+			OutputFormat: OutputFormatDiff,
+		},
+		FixCommand: nil,
+	}
+
+	testutil.InTempDir(t, func(dir string) {
+		testutil.WriteFile(t, "example.txt", []byte("one\n  two\nthree\n"), 0600)
+
+		results, err := processor.Execute(ctx, ".", []string{"example.txt"}, CommandTypeFix)
+		assert.NoError(t, err)
+		assert.Empty(t, results)
+
+		testutil.AssertFilePath(t, "example.txt", "one\ntwo\nthree\n")
+	})
+}
+
 func TestProcessor_Merge(t *testing.T) {
 	p1 := &Processor{
 		Name: "p1",
@@ -36,9 +78,11 @@ func TestProcessor_Merge(t *testing.T) {
 			InputType:    InputTypeNone,
 			OutputType:   OutputTypeStdout,
 			OutputFormat: OutputFormatJson,
-			ResultMapping: ResultMapping{
-				Level: render.MustCompile("p1-level"),
-				Path:  render.MustCompile("p1-path"),
+			ResultMappings: ResultMappings{
+				{
+					Level: render.MustCompile("p1-level"),
+					Path:  render.MustCompile("p1-path"),
+				},
 			},
 		},
 	}
@@ -49,8 +93,10 @@ func TestProcessor_Merge(t *testing.T) {
 		},
 		CheckCommand: &Command{
 			Template: "p2 --foo",
-			ResultMapping: ResultMapping{
-				Level: render.MustCompile("p2-level"),
+			ResultMappings: ResultMappings{
+				{
+					Level: render.MustCompile("p2-level"),
+				},
 			},
 		},
 	}
@@ -62,11 +108,88 @@ func TestProcessor_Merge(t *testing.T) {
 	assert.Equal(t, OutputTypeStdout, p3.CheckCommand.OutputType)
 	assert.Equal(t, OutputFormatJson, p3.CheckCommand.OutputFormat)
 
-	level, _ := p3.CheckCommand.ResultMapping.Level.Render(nil)
+	assert.Len(t, p3.CheckCommand.ResultMappings, 1)
+
+	level, _ := p3.CheckCommand.ResultMappings[0].Level.Render(nil)
 	assert.Equal(t, "p2-level", level)
 
-	path, _ := p3.CheckCommand.ResultMapping.Path.Render(nil)
+	path, _ := p3.CheckCommand.ResultMappings[0].Path.Render(nil)
 	assert.Equal(t, "p1-path", path)
+
+	// The receiver is left untouched.
+	level, _ = p1.CheckCommand.ResultMappings[0].Level.Render(nil)
+	assert.Equal(t, "p1-level", level)
+	assert.Equal(t, "p1 check --something", p1.CheckCommand.Template)
+}
+
+func TestProcessor_Merge_ResultMappings(t *testing.T) {
+	str := func(tpl *render.Template) string {
+		if tpl == nil {
+			return ""
+		}
+		s, _ := tpl.Render(nil)
+		return s
+	}
+	preset := &Processor{
+		CheckCommand: &Command{
+			ResultMappings: ResultMappings{
+				{Pattern: "error", Level: render.MustCompile("error"), Path: render.MustCompile("path")},
+				{Pattern: "warning", Level: render.MustCompile("warning"), Path: render.MustCompile("path")},
+			},
+		},
+	}
+
+	tests := []struct {
+		desc     string
+		mappings ResultMappings
+		expected [][3]string // pattern, level, path
+	}{
+		{
+			desc:     "keeps the mappings when there are none",
+			mappings: nil,
+			expected: [][3]string{{"error", "error", "path"}, {"warning", "warning", "path"}},
+		},
+		{
+			desc: "merges a single mapping into each mapping",
+			mappings: ResultMappings{
+				{Level: render.MustCompile("info")},
+			},
+			expected: [][3]string{{"error", "info", "path"}, {"warning", "info", "path"}},
+		},
+		{
+			desc: "merges lists of the same length by position",
+			mappings: ResultMappings{
+				{Level: render.MustCompile("info")},
+				{Pattern: "notice"},
+			},
+			expected: [][3]string{{"error", "info", "path"}, {"notice", "warning", "path"}},
+		},
+		{
+			desc: "replaces lists of a different length",
+			mappings: ResultMappings{
+				{Pattern: "one"},
+				{Pattern: "two"},
+				{Pattern: "three", Level: render.MustCompile("info")},
+			},
+			expected: [][3]string{{"one", "", ""}, {"two", "", ""}, {"three", "info", ""}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			merged := preset.Merge(&Processor{
+				CheckCommand: &Command{ResultMappings: tt.mappings},
+			})
+
+			actual := [][3]string{}
+			for _, m := range merged.CheckCommand.ResultMappings {
+				actual = append(actual, [3]string{m.Pattern, str(m.Level), str(m.Path)})
+			}
+			assert.Equal(t, tt.expected, actual)
+			// The preset is left untouched.
+			assert.Len(t, preset.CheckCommand.ResultMappings, 2)
+			assert.Equal(t, "error", str(preset.CheckCommand.ResultMappings[0].Level))
+		})
+	}
 }
 
 func TestProcessorFilter_Filter(t *testing.T) {
@@ -12,6 +12,36 @@ type Result struct {
 	Rule         ResultRule     `json:"rule"`
 	ContextLines []string       `json:"context_lines,omitempty"`
 	ContextLang  string         `json:"context_lang,omitempty"`
+	Fixes        []*ResultFix   `json:"fixes,omitempty"`
+
+	// Lines surrounding ContextLines when configured (see OutputConfig).
+	// Both are nil unless they were loaded from the file at Location.
+	ContextBefore []string `json:"context_before,omitempty"`
+	ContextAfter  []string `json:"context_after,omitempty"`
+
+	// Other locations relevant to the result (i.e. where a value was defined).
+	RelatedLocations []*ResultRelatedLocation `json:"related_locations,omitempty"`
+	// Execution paths that lead to the result (i.e. taint tracking).
+	CodeFlows []*ResultCodeFlow `json:"code_flows,omitempty"`
+}
+
+// HasSurroundingContext returns true if the surrounding context lines were loaded.
+func (r *Result) HasSurroundingContext() bool {
+	return r.ContextBefore != nil || r.ContextAfter != nil
+}
+
+// SecondaryLocations returns pointers to all the related and code flow locations.
+func (r *Result) SecondaryLocations() []*ResultLocation {
+	locations := []*ResultLocation{}
+	for _, related := range r.RelatedLocations {
+		locations = append(locations, &related.Location)
+	}
+	for _, flow := range r.CodeFlows {
+		for _, step := range flow.Locations {
+			locations = append(locations, &step.Location)
+		}
+	}
+	return locations
 }
 
 // ResultLocation describes the physical location where the result occurred.
@@ -41,6 +71,36 @@ func (r ResultLocation) String() string {
 	return fmt.Sprintf("%s:%d:%d", path, r.StartLine, r.StartColumn)
 }
 
+// ResultRelatedLocation describes a location related to a result.
+type ResultRelatedLocation struct {
+	Location ResultLocation `json:"location"`
+	Message  string         `json:"message,omitempty"`
+}
+
+// ResultCodeFlow describes an ordered sequence of locations
+// that were executed on the way to the result.
+type ResultCodeFlow struct {
+	Message   string                   `json:"message,omitempty"`
+	Locations []*ResultRelatedLocation `json:"locations"`
+}
+
+// ResultFix describes a single edit suggested by a processor.
+// All the fixes for a result are meant to be applied together.
+//
+// The edit range is expressed either as a line/column location or,
+// when the start line is 0, as a byte offset and length.
+// Columns are 1-based and the end column is exclusive.
+// An end column of 0 means the end of the line (excluding the newline).
+type ResultFix struct {
+	Location    ResultLocation `json:"location"`
+	ByteOffset  int            `json:"byte_offset,omitempty"`
+	ByteLength  int            `json:"byte_length,omitempty"`
+	Replacement string         `json:"replacement"`
+
+	// The unit the location columns are measured in (bytes by default).
+	columnKind columnKind
+}
+
 // ResultRule describes the rule that was evaluated to produce the result.
 type ResultRule struct {
 	ID          string `json:"id"`
@@ -109,12 +169,43 @@ func NewResultsError(results []*Result) error {
 	}
 }
 
+// NewThresholdResultsError returns a new error when any of the results are
+// at or above failLevel, or when the number of warnings exceeds maxWarnings.
+// A negative maxWarnings means there is no limit.
+func NewThresholdResultsError(results []*Result, failLevel ResultLevel, maxWarnings int) error {
+	failing := []*Result{}
+	warnings := 0
+	for _, r := range results {
+		if r.Level >= failLevel {
+			failing = append(failing, r)
+		}
+		if r.Level == ResultLevelWarning {
+			warnings++
+		}
+	}
+
+	if len(failing) > 0 {
+		return NewResultsError(failing)
+	}
+	if maxWarnings >= 0 && warnings > maxWarnings {
+		return &ResultsError{
+			results: results,
+			message: fmt.Sprintf("%d warning(s) exceeds the maximum of %d", warnings, maxWarnings),
+		}
+	}
+	return nil
+}
+
 // ResultsError is a sentinel type returned by actions when there are results.
 type ResultsError struct {
 	results []*Result
+	message string
 }
 
 // Error implements the error interface.
 func (re *ResultsError) Error() string {
+	if re.message != "" {
+		return re.message
+	}
 	return fmt.Sprintf("%d issue(s)", len(re.results))
 }
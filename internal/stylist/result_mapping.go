@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/twelvelabs/termite/render"
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -16,6 +17,23 @@ const (
 // and passed to a ResultMapping to be converted into a Result.
 type resultData map[string]any
 
+// ResultMappings is a list of mappings that are tried in order.
+type ResultMappings []ResultMapping
+
+// UnmarshalYAML allows `mapping` to be either a single mapping,
+// or a list of mappings (i.e. one per line shape).
+func (rm *ResultMappings) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.SequenceNode {
+		return node.Decode((*[]ResultMapping)(rm))
+	}
+	mapping := ResultMapping{}
+	if err := node.Decode(&mapping); err != nil {
+		return err
+	}
+	*rm = ResultMappings{mapping}
+	return nil
+}
+
 // ResultMapping is a set of rules for how to map command output to a result.
 //
 // Mappings are typically defined in stylist.yml when the output type
@@ -33,6 +51,22 @@ type ResultMapping struct {
 	RuleDescription *render.Template `yaml:"rule_description,omitempty"`
 	RuleURI         *render.Template `yaml:"rule_uri,omitempty"`
 	Context         *render.Template `yaml:"context,omitempty"`
+	Fix             *FixMapping      `yaml:"fix,omitempty"`
+}
+
+// FixMapping is a set of rules for how to map command output to a fix.
+//
+// A fix is only created when the start line or byte offset renders
+// to a non-empty value.
+type FixMapping struct {
+	Path        *render.Template `yaml:"path,omitempty"`
+	StartLine   *render.Template `yaml:"start_line,omitempty"`
+	StartColumn *render.Template `yaml:"start_column,omitempty"`
+	EndLine     *render.Template `yaml:"end_line,omitempty"`
+	EndColumn   *render.Template `yaml:"end_column,omitempty"`
+	ByteOffset  *render.Template `yaml:"byte_offset,omitempty"`
+	ByteLength  *render.Template `yaml:"byte_length,omitempty"`
+	Replacement *render.Template `yaml:"replacement,omitempty"`
 }
 
 // ToResult converts a map of output data to a Result struct.
@@ -91,6 +125,14 @@ func (m ResultMapping) ToResult(item resultData) (*Result, error) {
 		return nil, err
 	}
 
+	fix, err := m.RenderFix(item)
+	if err != nil {
+		return nil, err
+	}
+	if fix != nil {
+		result.Fixes = []*ResultFix{fix}
+	}
+
 	return result, nil
 }
 
@@ -121,6 +163,65 @@ func (m ResultMapping) RenderLevel(item resultData) (ResultLevel, error) {
 	return CoerceResultLevel(rendered)
 }
 
+// RenderFix renders the Fix templates using item.
+// Returns nil if no fix mapping is defined or the item has no fix.
+func (m ResultMapping) RenderFix(item resultData) (*ResultFix, error) {
+	if m.Fix == nil {
+		return nil, nil
+	}
+
+	var err error
+	fix := &ResultFix{}
+
+	fix.Location.StartLine, err = m.RenderInt(m.Fix.StartLine, item)
+	if err != nil {
+		return nil, err
+	}
+	offset, err := m.RenderString(m.Fix.ByteOffset, item)
+	if err != nil {
+		return nil, err
+	}
+	if fix.Location.StartLine == 0 && offset == strEmpty {
+		return nil, nil // nothing to fix
+	}
+	if offset != strEmpty {
+		fix.ByteOffset, err = strconv.Atoi(offset)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	fix.Location.Path, err = m.RenderString(m.Fix.Path, item)
+	if err != nil {
+		return nil, err
+	}
+	fix.Location.StartColumn, err = m.RenderInt(m.Fix.StartColumn, item)
+	if err != nil {
+		return nil, err
+	}
+	fix.Location.EndLine, err = m.RenderInt(m.Fix.EndLine, item)
+	if err != nil {
+		return nil, err
+	}
+	fix.Location.EndColumn, err = m.RenderInt(m.Fix.EndColumn, item)
+	if err != nil {
+		return nil, err
+	}
+	fix.ByteLength, err = m.RenderInt(m.Fix.ByteLength, item)
+	if err != nil {
+		return nil, err
+	}
+	// Not using RenderString because whitespace is significant here.
+	if m.Fix.Replacement != nil {
+		fix.Replacement, err = m.Fix.Replacement.Render(item)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return fix, nil
+}
+
 // RenderInt renders a template with the given output data and returns
 // the rendered value as an int.
 func (m ResultMapping) RenderInt(t *render.Template, item resultData) (int, error) {
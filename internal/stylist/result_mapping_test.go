@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/twelvelabs/termite/render"
+	"gopkg.in/yaml.v3"
 )
 
 func newResultDataFixture() resultData {
@@ -62,6 +63,50 @@ func newResultFixture() *Result {
 	}
 }
 
+func TestResultMappings_UnmarshalYAML(t *testing.T) {
+	tests := []struct {
+		desc     string
+		yaml     string
+		expected []string
+		err      string
+	}{
+		{
+			desc:     "decodes a single mapping",
+			yaml:     `mapping: {pattern: "one"}`,
+			expected: []string{"one"},
+		},
+		{
+			desc:     "decodes a list of mappings",
+			yaml:     `mapping: [{pattern: "one"}, {pattern: "two"}]`,
+			expected: []string{"one", "two"},
+		},
+		{
+			desc: "returns an error when invalid",
+			yaml: `mapping: "one"`,
+			err:  "cannot unmarshal",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			command := &Command{}
+			err := yaml.Unmarshal([]byte(tt.yaml), command)
+
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+
+			patterns := []string{}
+			for _, mapping := range command.ResultMappings {
+				patterns = append(patterns, mapping.Pattern)
+			}
+			assert.Equal(t, tt.expected, patterns)
+		})
+	}
+}
+
 func TestResultMapping_ToResult(t *testing.T) {
 	tests := []struct {
 		desc     string
@@ -208,6 +253,45 @@ func TestResultMapping_ToResult(t *testing.T) {
 			},
 			err: "fail: boom",
 		},
+		{
+			desc:     "should convert fix data into a result fix",
+			data:     newResultDataFixture(),
+			mapping:  newResultMappingFixture(),
+			expected: newResultFixture(),
+			setup: func(d *resultData, m *ResultMapping, r *Result) {
+				(*d)["fix_text"] = "new"
+				m.Fix = &FixMapping{
+					StartLine:   render.MustCompile(`{{ .start_line }}`),
+					StartColumn: render.MustCompile(`{{ .start_column }}`),
+					EndLine:     render.MustCompile(`{{ .end_line }}`),
+					EndColumn:   render.MustCompile(`{{ .end_column }}`),
+					Replacement: render.MustCompile(`{{ .fix_text }}`),
+				}
+				r.Fixes = []*ResultFix{
+					{
+						Location: ResultLocation{
+							StartLine:   1,
+							StartColumn: 11,
+							EndLine:     2,
+							EndColumn:   22,
+						},
+						Replacement: "new",
+					},
+				}
+			},
+		},
+		{
+			desc:     "should handle error when rendering Fix",
+			data:     newResultDataFixture(),
+			mapping:  newResultMappingFixture(),
+			expected: nil,
+			setup: func(d *resultData, m *ResultMapping, r *Result) {
+				m.Fix = &FixMapping{
+					StartLine: render.MustCompile(`{{ fail "boom" }}`),
+				}
+			},
+			err: "fail: boom",
+		},
 	}
 
 	for _, tt := range tests {
@@ -249,6 +333,82 @@ func TestResultMapping_ToResultSlice(t *testing.T) {
 	assert.Equal(t, 0, len(results))
 }
 
+func TestResultMapping_RenderFix(t *testing.T) {
+	tests := []struct {
+		desc     string
+		fix      *FixMapping
+		data     resultData
+		expected *ResultFix
+		err      string
+	}{
+		{
+			desc:     "missing fix mapping should return nil",
+			fix:      nil,
+			data:     resultData{},
+			expected: nil,
+		},
+		{
+			desc: "missing start line and byte offset should return nil",
+			fix: &FixMapping{
+				StartLine:   render.MustCompile(`{{ .line }}`),
+				ByteOffset:  render.MustCompile(`{{ .offset }}`),
+				Replacement: render.MustCompile(`{{ .text }}`),
+			},
+			data: resultData{
+				"text": "new",
+			},
+			expected: nil,
+		},
+		{
+			desc: "byte ranges should be rendered",
+			fix: &FixMapping{
+				Path:        render.MustCompile(`{{ .path }}`),
+				ByteOffset:  render.MustCompile(`{{ index .range 0 }}`),
+				ByteLength:  render.MustCompile(`{{ sub (index .range 1) (index .range 0) }}`),
+				Replacement: render.MustCompile(`{{ .text }}`),
+			},
+			data: resultData{
+				"path":  "foo.js",
+				"range": []any{0, 3},
+				"text":  "  ",
+			},
+			expected: &ResultFix{
+				Location: ResultLocation{
+					Path: "foo.js",
+				},
+				ByteOffset:  0,
+				ByteLength:  3,
+				Replacement: "  ",
+			},
+		},
+		{
+			desc: "should return an error when byte offset is not an int",
+			fix: &FixMapping{
+				ByteOffset: render.MustCompile(`{{ .offset }}`),
+			},
+			data: resultData{
+				"offset": "nope",
+			},
+			expected: nil,
+			err:      "invalid syntax",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			mapping := ResultMapping{Fix: tt.fix}
+			actual, err := mapping.RenderFix(tt.data)
+
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
 func TestResultMapping_RenderLevel(t *testing.T) {
 	tests := []struct {
 		desc     string
@@ -2,19 +2,32 @@ package stylist
 
 import (
 	"bytes"
+	_ "embed"
 	"encoding/json"
 	"encoding/xml"
 	"fmt"
+	"html"
+	htmltemplate "html/template"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
 	"strings"
+	"text/template"
+	"time"
 
 	"github.com/alecthomas/chroma/v2"
 	"github.com/alecthomas/chroma/v2/formatters"
+	chromahtml "github.com/alecthomas/chroma/v2/formatters/html"
 	"github.com/alecthomas/chroma/v2/lexers"
 	"github.com/alecthomas/chroma/v2/styles"
 	"github.com/owenrumney/go-sarif/v2/sarif"
+	"github.com/twelvelabs/termite/render"
 	"github.com/twelvelabs/termite/ui"
 
 	"github.com/twelvelabs/stylist/internal/checkstyle"
+	"github.com/twelvelabs/stylist/internal/junit"
+	"github.com/twelvelabs/stylist/internal/rdjson"
 )
 
 // ResultPrinter is the interface that wraps the Print method.
@@ -23,18 +36,41 @@ type ResultPrinter interface {
 	Print(results []*Result) error
 }
 
+// RunInfo describes the run that produced the results being printed.
+type RunInfo struct {
+	// The paths processed by each processor, keyed by processor name.
+	Paths map[string][]string
+	// When the run started (used to report the elapsed time).
+	StartedAt time.Time
+}
+
 // NewResultPrinter returns the appropriate printer for the given format.
-func NewResultPrinter(ios *ui.IOStreams, config *Config) ResultPrinter { //nolint:ireturn
+func NewResultPrinter(ios *ui.IOStreams, config *Config, run *RunInfo) ResultPrinter { //nolint:ireturn
+	if run == nil {
+		run = &RunInfo{}
+	}
 	format := config.Output.Format
 	switch format {
 	case ResultFormatCheckstyle:
 		return &CheckstylePrinter{ios: ios, config: config}
+	case ResultFormatHtml:
+		return &HTMLPrinter{ios: ios, config: config}
 	case ResultFormatJson:
 		return &JSONPrinter{ios: ios, config: config}
+	case ResultFormatJunit:
+		return &JUnitPrinter{ios: ios, config: config, run: run}
+	case ResultFormatMarkdown:
+		return &MarkdownPrinter{ios: ios, config: config}
+	case ResultFormatRdjson:
+		return &RDJSONPrinter{ios: ios, config: config}
+	case ResultFormatRdjsonl:
+		return &RDJSONLPrinter{ios: ios, config: config}
 	case ResultFormatSarif:
 		return &SarifPrinter{ios: ios, config: config}
+	case ResultFormatTemplate:
+		return &TemplatePrinter{ios: ios, config: config}
 	case ResultFormatTty:
-		return &TtyPrinter{ios: ios, config: config}
+		return &TtyPrinter{ios: ios, config: config, startedAt: run.StartedAt}
 	default:
 		panic(fmt.Sprintf("unknown result format: %s", format))
 	}
@@ -89,6 +125,136 @@ func (p *CheckstylePrinter) Print(results []*Result) error {
 	return err
 }
 
+/*
+* HTMLPrinter
+**/
+
+//go:embed result_printer_html.tmpl
+var htmlReportTemplate string
+
+// HTMLPrinter generates a self-contained HTML report.
+type HTMLPrinter struct {
+	ios    *ui.IOStreams
+	config *Config
+}
+
+type htmlReport struct {
+	CSS     htmltemplate.CSS
+	Count   int
+	Files   []*htmlFile
+	Sources []string
+	Levels  []string
+	Rules   []string
+}
+
+type htmlFile struct {
+	Path    string
+	Results []*htmlResult
+}
+
+type htmlResult struct {
+	Source      string
+	Level       string
+	Location    string
+	Description string
+	RuleID      string
+	RuleURI     string
+	RuleKey     string
+	Context     htmltemplate.HTML
+}
+
+// Print writes the HTML report to Stdout.
+func (p *HTMLPrinter) Print(results []*Result) error {
+	tmpl, err := htmltemplate.New("report").Parse(htmlReportTemplate)
+	if err != nil {
+		return err
+	}
+
+	style := styles.Get("github")
+	if style == nil {
+		style = styles.Fallback
+	}
+	formatter := chromahtml.New(chromahtml.WithClasses(true))
+	css := &bytes.Buffer{}
+	if err := formatter.WriteCSS(css, style); err != nil {
+		return err
+	}
+
+	report := &htmlReport{
+		CSS:   htmltemplate.CSS(css.String()), //nolint:gosec // generated by chroma
+		Count: len(results),
+	}
+	files := map[string]*htmlFile{}
+	sources := map[string]bool{}
+	levels := map[ResultLevel]bool{}
+	rules := map[string]bool{}
+	for _, r := range results {
+		file, ok := files[r.Location.Path]
+		if !ok {
+			file = &htmlFile{Path: r.Location.Path}
+			files[r.Location.Path] = file
+			report.Files = append(report.Files, file)
+		}
+
+		result := &htmlResult{
+			Source:      r.Source,
+			Level:       r.Level.String(),
+			Location:    r.Location.String(),
+			Description: r.Rule.Description,
+			RuleID:      r.Rule.ID,
+			RuleURI:     r.Rule.URI,
+		}
+		if r.Rule.ID != "" {
+			result.RuleKey = r.Source + "/" + r.Rule.ID
+			rules[result.RuleKey] = true
+		}
+		if p.config.Output.ShowContext && len(r.ContextLines) > 0 {
+			result.Context, err = p.highlight(r, style)
+			if err != nil {
+				return err
+			}
+		}
+		file.Results = append(file.Results, result)
+
+		sources[r.Source] = true
+		levels[r.Level] = true
+	}
+
+	report.Sources = slices.Sorted(maps.Keys(sources))
+	report.Rules = slices.Sorted(maps.Keys(rules))
+	for _, level := range slices.Backward(slices.Sorted(maps.Keys(levels))) {
+		report.Levels = append(report.Levels, level.String())
+	}
+
+	return tmpl.Execute(p.ios.Out, report)
+}
+
+// highlight renders the context lines of the result as HTML.
+func (p *HTMLPrinter) highlight(r *Result, style *chroma.Style) (htmltemplate.HTML, error) {
+	text := strings.Join(r.ContextLines, "\n") + "\n"
+	lexer := lexers.Fallback
+	if p.config.Output.SyntaxHighlight {
+		lexer = resolveLexer(text, r.Location.Path, r.ContextLang)
+	}
+
+	start, _ := r.Location.LineRange()
+	formatter := chromahtml.New(
+		chromahtml.WithClasses(true),
+		chromahtml.WithLineNumbers(true),
+		chromahtml.BaseLineNumber(start),
+	)
+
+	it, err := chroma.Coalesce(lexer).Tokenise(nil, text)
+	if err != nil {
+		return "", err
+	}
+	buf := &bytes.Buffer{}
+	if err := formatter.Format(buf, style, it); err != nil {
+		return "", err
+	}
+	return htmltemplate.HTML(buf.String()), nil //nolint:gosec // escaped by chroma
+}
+
 /*
 * JSONPrinter
 **/
@@ -105,6 +271,8 @@ func (p *JSONPrinter) Print(results []*Result) error {
 		for _, r := range results {
 			r.ContextLines = nil
 			r.ContextLang = ""
+			r.ContextBefore = nil
+			r.ContextAfter = nil
 		}
 	}
 
@@ -117,6 +285,375 @@ func (p *JSONPrinter) Print(results []*Result) error {
 	return err
 }
 
+/*
+* JUnitPrinter
+**/
+
+// JUnitPrinter generates JUnit XML formatted output.
+// Each processor is rendered as a test suite, and each file it checked
+// as a test case in that suite (failing if the file has results).
+// Processors that did not check any files are rendered as a single test case.
+type JUnitPrinter struct {
+	ios    *ui.IOStreams
+	config *Config
+	run    *RunInfo
+}
+
+// Print writes the JUnit XML formatted results to Stdout.
+func (p *JUnitPrinter) Print(results []*Result) error {
+	suites := map[string]*junit.JUTestSuite{}
+	cases := map[string]map[string]*junit.JUTestCase{}
+	levels := map[*junit.JUTestCase]ResultLevel{}
+	counts := map[*junit.JUTestCase]int{}
+	report := &junit.JUTestSuites{}
+
+	testCase := func(source string, name string) *junit.JUTestCase {
+		suite, ok := suites[source]
+		if !ok {
+			suite = &junit.JUTestSuite{Name: source}
+			suites[source] = suite
+			cases[source] = map[string]*junit.JUTestCase{}
+		}
+		tc, ok := cases[source][name]
+		if !ok {
+			tc = &junit.JUTestCase{
+				Name:      name,
+				ClassName: source,
+			}
+			cases[source][name] = tc
+			suite.TestCases = append(suite.TestCases, tc)
+			suite.Tests++
+			report.Tests++
+		}
+		return tc
+	}
+
+	if p.run != nil {
+		cwd, _ := os.Getwd()
+		adjuster := NewPathAdjuster(cwd, p.config.Output.Paths)
+		for source, paths := range p.run.Paths {
+			if len(paths) == 0 {
+				testCase(source, source)
+			}
+			for _, path := range paths {
+				displayPath, err := adjuster.Convert(path)
+				if err != nil {
+					return err
+				}
+				testCase(source, displayPath)
+			}
+		}
+	}
+
+	for _, r := range results {
+		tc := testCase(r.Source, r.Location.Path)
+		if tc.Failure == nil {
+			tc.Failure = &junit.JUFailure{}
+			suites[r.Source].Failures++
+			report.Failures++
+		}
+
+		line := fmt.Sprintf("%s: %s: %s", r.Location.String(), r.Level.String(), r.Rule.Description)
+		if r.Rule.ID != "" {
+			line += fmt.Sprintf(" [%s]", r.Rule.ID)
+		}
+		tc.Failure.Content += line + "\n"
+		counts[tc]++
+
+		if r.Level >= levels[tc] {
+			levels[tc] = r.Level
+			tc.Failure.Type = r.Level.String()
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(suites)) {
+		suite := suites[name]
+		slices.SortStableFunc(suite.TestCases, func(a, b *junit.JUTestCase) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+		for _, tc := range suite.TestCases {
+			if tc.Failure != nil {
+				tc.Failure.Message = fmt.Sprintf("%d issue(s)", counts[tc])
+			}
+		}
+		report.Suites = append(report.Suites, suite)
+	}
+
+	buf, err := xml.Marshal(report)
+	if err != nil {
+		return err
+	}
+
+	doc := xml.Header + string(buf) + "\n"
+	_, err = fmt.Fprint(p.ios.Out, doc)
+	return err
+}
+
+/*
+* MarkdownPrinter
+**/
+
+// MarkdownPrinter generates Markdown formatted output.
+// The output is intended for $GITHUB_STEP_SUMMARY or PR comments.
+type MarkdownPrinter struct {
+	ios    *ui.IOStreams
+	config *Config
+}
+
+// Print writes the Markdown formatted results to Stdout.
+func (p *MarkdownPrinter) Print(results []*Result) error {
+	buf := &strings.Builder{}
+	buf.WriteString("## Stylist results\n\n")
+
+	if len(results) == 0 {
+		buf.WriteString("No issues found.\n")
+		_, err := fmt.Fprint(p.ios.Out, buf.String())
+		return err
+	}
+
+	p.writeSummary(buf, results)
+
+	// Group results by path, preserving the (sorted) order of the results.
+	resultsByPath := map[string][]*Result{}
+	paths := []string{}
+	for _, r := range results {
+		path := r.Location.Path
+		if _, ok := resultsByPath[path]; !ok {
+			paths = append(paths, path)
+		}
+		resultsByPath[path] = append(resultsByPath[path], r)
+	}
+	for _, path := range paths {
+		p.writeFile(buf, path, resultsByPath[path])
+	}
+
+	_, err := fmt.Fprint(p.ios.Out, buf.String())
+	return err
+}
+
+// writeSummary writes a table of result counts per processor and severity.
+func (p *MarkdownPrinter) writeSummary(buf *strings.Builder, results []*Result) {
+	// Severities from most to least severe.
+	levels := ResultLevelNames()
+	slices.Reverse(levels)
+
+	counts := map[string]map[string]int{}
+	totals := map[string]int{}
+	for _, r := range results {
+		if _, ok := counts[r.Source]; !ok {
+			counts[r.Source] = map[string]int{}
+		}
+		counts[r.Source][r.Level.String()]++
+		totals[r.Level.String()]++
+	}
+
+	buf.WriteString("| Processor |")
+	for _, level := range levels {
+		fmt.Fprintf(buf, " %s |", level)
+	}
+	buf.WriteString(" total |\n")
+	buf.WriteString("| --- |" + strings.Repeat(" ---: |", len(levels)+1) + "\n")
+
+	writeRow := func(name string, row map[string]int) {
+		total := 0
+		fmt.Fprintf(buf, "| %s |", name)
+		for _, level := range levels {
+			fmt.Fprintf(buf, " %d |", row[level])
+			total += row[level]
+		}
+		fmt.Fprintf(buf, " %d |\n", total)
+	}
+	sources := slices.Sorted(maps.Keys(counts))
+	for _, source := range sources {
+		writeRow(markdownEscape(source), counts[source])
+	}
+	writeRow("**Total**", totals)
+	buf.WriteString("\n")
+}
+
+// writeFile writes a collapsible section containing the results for path.
+func (p *MarkdownPrinter) writeFile(buf *strings.Builder, path string, results []*Result) {
+	fmt.Fprintf(buf, "<details>\n<summary><code>%s</code> (%d issue(s))</summary>\n\n",
+		html.EscapeString(path), len(results))
+
+	for _, r := range results {
+		rule := ""
+		if r.Rule.ID != "" {
+			rule = fmt.Sprintf(" `%s`", r.Rule.ID)
+			if r.Rule.URI != "" {
+				rule = fmt.Sprintf(" [`%s`](%s)", r.Rule.ID, r.Rule.URI)
+			}
+		}
+		fmt.Fprintf(buf, "- **%s** %s%s: %s (`%s`)\n",
+			r.Level.String(),
+			markdownEscape(r.Source),
+			rule,
+			markdownEscape(r.Rule.Description),
+			r.Location.String(),
+		)
+
+		if p.config.Output.ShowContext && len(r.ContextLines) > 0 {
+			fence := markdownFence(r.ContextLines)
+			fmt.Fprintf(buf, "\n  %s%s\n", fence, r.ContextLang)
+			for _, line := range r.ContextLines {
+				fmt.Fprintf(buf, "  %s\n", line)
+			}
+			fmt.Fprintf(buf, "  %s\n\n", fence)
+		}
+	}
+
+	buf.WriteString("\n</details>\n\n")
+}
+
+// markdownEscape escapes the characters that would otherwise
+// break inline Markdown (tables, emphasis, HTML).
+func markdownEscape(text string) string {
+	replacer := strings.NewReplacer(
+		"\\", "\\\\",
+		"|", "\\|",
+		"*", "\\*",
+		"_", "\\_",
+		"`", "\\`",
+		"<", "&lt;",
+		">", "&gt;",
+		"\n", " ",
+	)
+	return replacer.Replace(text)
+}
+
+// markdownFence returns a code fence longer than any backtick run in lines.
+func markdownFence(lines []string) string {
+	longest := 0
+	for _, line := range lines {
+		run := 0
+		for _, c := range line {
+			if c == '`' {
+				run++
+				longest = max(longest, run)
+			} else {
+				run = 0
+			}
+		}
+	}
+	return strings.Repeat("`", max(3, longest+1))
+}
+
+/*
+* RDJSONPrinter
+**/
+
+// RDJSONPrinter generates reviewdog rdjson formatted output.
+type RDJSONPrinter struct {
+	ios    *ui.IOStreams
+	config *Config
+}
+
+// Print writes the rdjson formatted results to Stdout.
+func (p *RDJSONPrinter) Print(results []*Result) error {
+	doc := &rdjson.RDDiagnosticResult{
+		Source:      &rdjson.RDSource{Name: "stylist"},
+		Diagnostics: []*rdjson.RDDiagnostic{},
+	}
+	for _, r := range results {
+		doc.Diagnostics = append(doc.Diagnostics, rdDiagnosticFromResult(r))
+	}
+
+	buf, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprint(p.ios.Out, string(buf)+"\n")
+	return err
+}
+
+/*
+* RDJSONLPrinter
+**/
+
+// RDJSONLPrinter generates reviewdog rdjsonl formatted output.
+type RDJSONLPrinter struct {
+	ios    *ui.IOStreams
+	config *Config
+}
+
+// Print writes the rdjsonl formatted results to Stdout.
+func (p *RDJSONLPrinter) Print(results []*Result) error {
+	for _, r := range results {
+		buf, err := json.Marshal(rdDiagnosticFromResult(r))
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprint(p.ios.Out, string(buf)+"\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func rdDiagnosticFromResult(r *Result) *rdjson.RDDiagnostic {
+	d := &rdjson.RDDiagnostic{
+		Message: r.Rule.Description,
+		Location: &rdjson.RDLocation{
+			Path:  r.Location.Path,
+			Range: rdRangeFromLocation(r.Location),
+		},
+		Severity: rdSeverityFromLevel(r.Level),
+		Source:   &rdjson.RDSource{Name: r.Source},
+	}
+	if r.Rule.ID != "" {
+		d.Code = &rdjson.RDCode{
+			Value: r.Rule.ID,
+			URL:   r.Rule.URI,
+		}
+	}
+	for _, fix := range r.Fixes {
+		// Reviewdog only understands line/column ranges
+		// within the same file as the diagnostic.
+		if fix.Location.StartLine == 0 ||
+			(fix.Location.Path != "" && fix.Location.Path != r.Location.Path) {
+			continue
+		}
+		d.Suggestions = append(d.Suggestions, &rdjson.RDSuggestion{
+			Range: rdRangeFromLocation(fix.Location),
+			Text:  fix.Replacement,
+		})
+	}
+	return d
+}
+
+func rdRangeFromLocation(loc ResultLocation) *rdjson.RDRange {
+	if loc.StartLine == 0 {
+		return nil
+	}
+	r := &rdjson.RDRange{
+		Start: &rdjson.RDPosition{
+			Line:   loc.StartLine,
+			Column: loc.StartColumn,
+		},
+	}
+	if loc.EndLine != 0 {
+		r.End = &rdjson.RDPosition{
+			Line:   loc.EndLine,
+			Column: loc.EndColumn,
+		}
+	}
+	return r
+}
+
+func rdSeverityFromLevel(level ResultLevel) string {
+	switch level {
+	case ResultLevelError:
+		return rdjson.SeverityError
+	case ResultLevelWarning:
+		return rdjson.SeverityWarning
+	case ResultLevelInfo:
+		return rdjson.SeverityInfo
+	default:
+		return rdjson.SeverityUnknown
+	}
+}
+
 /*
 * SarifPrinter
 **/
@@ -179,19 +716,28 @@ func (p *SarifPrinter) Print(results []*Result) error {
 				}
 			}
 
+			physicalLocation := sarif.NewPhysicalLocation().
+				WithArtifactLocation(
+					sarif.NewSimpleArtifactLocation(r.Location.Path),
+				).
+				WithRegion(region)
+			if p.config.Output.ShowContext && r.HasSurroundingContext() {
+				physicalLocation.WithContextRegion(sarifContextRegion(r))
+			}
+
 			// Add the result to the run.
-			run.CreateResultForRule(r.Rule.ID).
+			result := run.CreateResultForRule(r.Rule.ID).
 				WithLevel(r.Level.String()).
-				WithMessage(sarif.NewTextMessage(r.Rule.Description)).
-				AddLocation(
-					sarif.NewLocationWithPhysicalLocation(
-						sarif.NewPhysicalLocation().
-							WithArtifactLocation(
-								sarif.NewSimpleArtifactLocation(r.Location.Path),
-							).
-							WithRegion(region),
-					),
-				)
+				WithMessage(sarif.NewTextMessage(r.Rule.Description))
+			result.AddLocation(
+				sarif.NewLocationWithPhysicalLocation(physicalLocation),
+			)
+			for _, related := range r.RelatedLocations {
+				result.AddRelatedLocation(sarifRelatedLocation(related))
+			}
+			for _, flow := range r.CodeFlows {
+				result.AddCodeFlow(sarifCodeFlow(flow))
+			}
 		}
 		report.AddRun(run)
 	}
@@ -199,6 +745,159 @@ func (p *SarifPrinter) Print(results []*Result) error {
 	return report.Write(p.ios.Out)
 }
 
+// sarifRelatedLocation converts a related location to a SARIF location.
+func sarifRelatedLocation(related *ResultRelatedLocation) *sarif.Location {
+	loc := sarif.NewLocation()
+	if related.Location.Path != "" {
+		loc.WithPhysicalLocation(
+			sarif.NewPhysicalLocation().
+				WithArtifactLocation(
+					sarif.NewSimpleArtifactLocation(related.Location.Path),
+				).
+				WithRegion(
+					sarif.NewRegion().
+						WithStartLine(related.Location.StartLine).
+						WithStartColumn(related.Location.StartColumn).
+						WithEndLine(related.Location.EndLine).
+						WithEndColumn(related.Location.EndColumn),
+				),
+		)
+	}
+	if related.Message != "" {
+		loc.WithMessage(sarif.NewTextMessage(related.Message))
+	}
+	return loc
+}
+
+// sarifCodeFlow converts a code flow to a SARIF code flow
+// with a single thread flow.
+func sarifCodeFlow(flow *ResultCodeFlow) *sarif.CodeFlow {
+	thread := sarif.NewThreadFlow()
+	for _, step := range flow.Locations {
+		thread.AddLocation(
+			sarif.NewThreadFlowLocation().WithLocation(sarifRelatedLocation(step)),
+		)
+	}
+	codeFlow := sarif.NewCodeFlow().WithThreadFlows([]*sarif.ThreadFlow{thread})
+	if flow.Message != "" {
+		codeFlow.WithTextMessage(flow.Message)
+	}
+	return codeFlow
+}
+
+// sarifContextRegion returns a region containing the result lines
+// along with the surrounding context lines.
+func sarifContextRegion(r *Result) *sarif.Region {
+	start, end := r.Location.LineRange()
+	lines := slices.Concat(r.ContextBefore, r.ContextLines, r.ContextAfter)
+	return sarif.NewRegion().
+		WithStartLine(start - len(r.ContextBefore)).
+		WithEndLine(end + len(r.ContextAfter)).
+		WithSnippet(
+			sarif.NewArtifactContent().WithText(strings.Join(lines, "\n")),
+		)
+}
+
+/*
+* TemplatePrinter
+**/
+
+// TemplatePrinter generates output using a user supplied Go template.
+//
+// The template is rendered with the results slice as data, using the
+// same functions as the rest of the config (see [render.FuncMap]) plus
+// a few result specific helpers (see [templatePrinterFuncs]).
+type TemplatePrinter struct {
+	ios    *ui.IOStreams
+	config *Config
+}
+
+// Print writes the template rendered results to Stdout.
+func (p *TemplatePrinter) Print(results []*Result) error {
+	text, err := p.templateText()
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := template.New("output.template").
+		Funcs(render.FuncMap).
+		Funcs(templatePrinterFuncs).
+		Parse(text)
+	if err != nil {
+		return fmt.Errorf("invalid output template: %w", err)
+	}
+
+	return tmpl.Execute(p.ios.Out, results)
+}
+
+// templateText returns the contents of the template file if
+// the configured template is a path, otherwise the template itself.
+func (p *TemplatePrinter) templateText() (string, error) {
+	value := p.config.Output.Template
+	if value == "" {
+		return "", fmt.Errorf("output template is required when output format is template")
+	}
+	if info, err := os.Stat(value); err == nil && !info.IsDir() {
+		buf, err := os.ReadFile(value)
+		if err != nil {
+			return "", err
+		}
+		return string(buf), nil
+	}
+	return value, nil
+}
+
+// templatePrinterFuncs are the helper functions available to output templates.
+var templatePrinterFuncs = template.FuncMap{
+	// groupBy returns the results grouped by key (path, source, rule, or level).
+	"groupBy": func(key string, results []*Result) (map[string][]*Result, error) {
+		groups := map[string][]*Result{}
+		for _, r := range results {
+			k, err := templateResultKey(key, r)
+			if err != nil {
+				return nil, err
+			}
+			groups[k] = append(groups[k], r)
+		}
+		return groups, nil
+	},
+	// countBy returns the number of results per key (path, source, rule, or level).
+	"countBy": func(key string, results []*Result) (map[string]int, error) {
+		counts := map[string]int{}
+		for _, r := range results {
+			k, err := templateResultKey(key, r)
+			if err != nil {
+				return nil, err
+			}
+			counts[k]++
+		}
+		return counts, nil
+	},
+	// jsonEscape returns s escaped for use inside a JSON string.
+	"jsonEscape": func(s string) (string, error) {
+		buf, err := json.Marshal(s)
+		if err != nil {
+			return "", err
+		}
+		return string(buf[1 : len(buf)-1]), nil
+	},
+}
+
+func templateResultKey(key string, r *Result) (string, error) {
+	switch key {
+	case "path", "file":
+		return r.Location.Path, nil
+	case "source":
+		return r.Source, nil
+	case "rule":
+		return r.Rule.ID, nil
+	case "level", "severity":
+		return r.Level.String(), nil
+	default:
+		return "", fmt.Errorf("unknown result key: %s", key)
+	}
+}
+
 /*
 * TtyPrinter
 **/
@@ -206,40 +905,102 @@ func (p *SarifPrinter) Print(results []*Result) error {
 // TtyPrinter generates TTY formatted output.
 // The output will contain ANSI color codes if the terminal allows them.
 type TtyPrinter struct {
-	ios    *ui.IOStreams
-	config *Config
+	ios       *ui.IOStreams
+	config    *Config
+	startedAt time.Time
+
+	// Prefix for each line when printing a group of results.
+	indent string
 }
 
 // Print writes the TTY formatted results to Stdout.
 func (p *TtyPrinter) Print(results []*Result) error {
 	formatter := p.ios.Formatter()
-	for _, result := range results {
-		p.printLocation(result, formatter)
-		if p.config.Output.ShowContext {
-			p.printContext(result)
-			p.printUnderLinePointer(result, formatter)
+
+	groupBy := p.config.Output.GroupBy
+	if groupBy == "" || groupBy == ResultGroupNone {
+		for _, result := range results {
+			p.printResult(result, formatter)
+		}
+	} else {
+		keys, groups := p.groupResults(results)
+		for idx, key := range keys {
+			if idx > 0 {
+				fmt.Fprintln(p.ios.Out)
+			}
+			p.printGroupHeader(key, groups[key][0], formatter)
+			p.indent = "  "
+			for _, result := range groups[key] {
+				p.printResult(result, formatter)
+			}
+			p.indent = ""
 		}
 	}
+
+	if p.config.Output.ShowSummary {
+		p.printSummary(results, formatter)
+	}
 	return nil
 }
 
+// groupResults groups the results by the configured key,
+// preserving the (sorted) order in which each key first appears.
+func (p *TtyPrinter) groupResults(results []*Result) ([]string, map[string][]*Result) {
+	keys := []string{}
+	groups := map[string][]*Result{}
+	for _, r := range results {
+		key := r.Location.Path
+		switch p.config.Output.GroupBy {
+		case ResultGroupSource:
+			key = r.Source
+		case ResultGroupRule:
+			key = r.Source + "/" + r.Rule.ID
+		default:
+		}
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], r)
+	}
+	return keys, groups
+}
+
+func (p *TtyPrinter) printGroupHeader(key string, first *Result, formatter *ui.Formatter) {
+	header := formatter.Bold(formatter.Underline(key))
+	if p.config.Output.GroupBy == ResultGroupRule && first.Rule.URI != "" && p.config.Output.ShowURL {
+		header += fmt.Sprintf(" (%s)", first.Rule.URI)
+	}
+	fmt.Fprintln(p.ios.Out, header)
+}
+
+func (p *TtyPrinter) printResult(result *Result, formatter *ui.Formatter) {
+	p.printLocation(result, formatter)
+	if p.config.Output.ShowContext {
+		if result.HasSurroundingContext() {
+			p.printContextWithGutter(result, formatter)
+		} else {
+			p.printContext(result)
+			p.printUnderLinePointer(result, "", formatter)
+		}
+	}
+}
+
 func (p *TtyPrinter) printLocation(result *Result, formatter *ui.Formatter) {
-	severity := result.Level.String()
-	switch result.Level {
-	case ResultLevelError:
-		severity = formatter.Red(severity) + ": "
-	case ResultLevelWarning:
-		severity = formatter.Yellow(severity) + ": "
-	case ResultLevelInfo:
-		severity = formatter.Cyan(severity) + ": "
-	case ResultLevelNone:
-		severity = formatter.Gray(severity) + ": "
-	default:
+	groupBy := p.config.Output.GroupBy
+
+	location := result.Location.String()
+	if groupBy == ResultGroupFile {
+		// The path is already in the group header.
+		location = fmt.Sprintf("%d:%d", result.Location.StartLine, result.Location.StartColumn)
 	}
 
+	severity := p.formatLevel(result.Level, formatter) + ": "
+
 	source := result.Source
-	if source != "" {
+	if source != "" && groupBy != ResultGroupSource && groupBy != ResultGroupRule {
 		source = formatter.Underline(source) + ": "
+	} else {
+		source = ""
 	}
 
 	msg := result.Rule.Description
@@ -251,22 +1012,41 @@ func (p *TtyPrinter) printLocation(result *Result, formatter *ui.Formatter) {
 	}
 
 	rule := ""
-	if result.Rule.ID != "" {
-		rule = fmt.Sprintf("[%s]", result.Rule.ID)
-	}
-	if result.Rule.URI != "" && p.config.Output.ShowURL {
-		rule = fmt.Sprintf("%s(%s)", rule, result.Rule.URI)
+	if groupBy != ResultGroupRule {
+		if result.Rule.ID != "" {
+			rule = fmt.Sprintf("[%s]", result.Rule.ID)
+		}
+		if result.Rule.URI != "" && p.config.Output.ShowURL {
+			rule = fmt.Sprintf("%s(%s)", rule, result.Rule.URI)
+		}
 	}
 
-	fmt.Fprintf(
-		p.ios.Out,
-		"%s: %s%s%s%s\n",
-		formatter.Bold(result.Location.String()),
+	line := fmt.Sprintf(
+		"%s%s: %s%s%s%s",
+		p.indent,
+		formatter.Bold(location),
 		severity,
 		source,
 		msg,
 		rule,
 	)
+	fmt.Fprintln(p.ios.Out, strings.TrimRight(line, " "))
+}
+
+func (p *TtyPrinter) formatLevel(level ResultLevel, formatter *ui.Formatter) string {
+	severity := level.String()
+	switch level {
+	case ResultLevelError:
+		return formatter.Red(severity)
+	case ResultLevelWarning:
+		return formatter.Yellow(severity)
+	case ResultLevelInfo:
+		return formatter.Cyan(severity)
+	case ResultLevelNone:
+		return formatter.Gray(severity)
+	default:
+		return severity
+	}
 }
 
 func (p *TtyPrinter) printContext(result *Result) {
@@ -280,26 +1060,96 @@ func (p *TtyPrinter) printContext(result *Result) {
 			contextLines, result.Location.Path, result.ContextLang,
 		)
 	}
+	if p.indent != "" {
+		lines := strings.Split(strings.TrimSuffix(contextLines, "\n"), "\n")
+		contextLines = p.indent + strings.Join(lines, "\n"+p.indent) + "\n"
+	}
 
 	fmt.Fprint(p.ios.Out, contextLines)
 }
 
-func (p *TtyPrinter) syntaxHighlight(text, path, lang string) (string, error) {
-	// seems the chroma author uses UK english :/
-	// cspell:words Analyse Tokenise
+// printContextWithGutter prints the context lines (and surrounding lines)
+// prefixed with their line numbers. The result lines are marked with ">".
+func (p *TtyPrinter) printContextWithGutter(result *Result, formatter *ui.Formatter) {
+	lines := slices.Concat(result.ContextBefore, result.ContextLines, result.ContextAfter)
+	if len(lines) == 0 {
+		return
+	}
 
-	// Resolve the lexer.
-	l := lexers.Get(lang)
-	if l == nil {
-		l = lexers.Match(path)
+	if p.config.Output.SyntaxHighlight && p.ios.IsColorEnabled() {
+		text := strings.Join(lines, "\n") + "\n"
+		highlighted, err := p.syntaxHighlight(text, result.Location.Path, result.ContextLang)
+		if err == nil {
+			lines = strings.Split(strings.TrimSuffix(highlighted, "\n"), "\n")
+		}
 	}
-	if l == nil {
-		l = lexers.Analyse(text)
+
+	start, _ := result.Location.LineRange()
+	first := start - len(result.ContextBefore)
+	width := len(strconv.Itoa(first + len(lines) - 1))
+	targetStart := len(result.ContextBefore)
+	targetEnd := targetStart + len(result.ContextLines)
+
+	for idx, line := range lines {
+		gutter := fmt.Sprintf("%*d | ", width, first+idx)
+		if idx >= targetStart && idx < targetEnd {
+			fmt.Fprintf(p.ios.Out, "%s%s%s%s\n", p.indent, formatter.Yellow("> "), formatter.Bold(gutter), line)
+		} else {
+			fmt.Fprintf(p.ios.Out, "%s  %s%s\n", p.indent, formatter.Gray(gutter), line)
+		}
+		if idx == targetEnd-1 {
+			p.printUnderLinePointer(result, strings.Repeat(" ", width+5), formatter)
+		}
 	}
-	if l == nil {
-		l = lexers.Fallback
+}
+
+// printSummary prints the number of results per severity and processor,
+// the number of files affected, and the elapsed time.
+func (p *TtyPrinter) printSummary(results []*Result, formatter *ui.Formatter) {
+	levels := map[ResultLevel]int{}
+	sources := map[string]int{}
+	paths := map[string]bool{}
+	for _, r := range results {
+		levels[r.Level]++
+		sources[r.Source]++
+		paths[r.Location.Path] = true
+	}
+
+	if len(results) > 0 {
+		fmt.Fprintln(p.ios.Out)
+	}
+	fmt.Fprintf(
+		p.ios.Out,
+		"%s in %d file(s)",
+		formatter.Bold(fmt.Sprintf("%d issue(s)", len(results))),
+		len(paths),
+	)
+	if !p.startedAt.IsZero() {
+		fmt.Fprintf(p.ios.Out, " (%s)", time.Since(p.startedAt).Round(time.Millisecond))
+	}
+	fmt.Fprintln(p.ios.Out)
+	if len(results) == 0 {
+		return
+	}
+
+	counts := []string{}
+	for _, level := range slices.Backward(slices.Sorted(maps.Keys(levels))) {
+		counts = append(counts, fmt.Sprintf("%s: %d", p.formatLevel(level, formatter), levels[level]))
+	}
+	fmt.Fprintf(p.ios.Out, "  severity: %s\n", strings.Join(counts, ", "))
+
+	counts = []string{}
+	for _, source := range slices.Sorted(maps.Keys(sources)) {
+		counts = append(counts, fmt.Sprintf("%s: %d", source, sources[source]))
 	}
-	l = chroma.Coalesce(l)
+	fmt.Fprintf(p.ios.Out, "  processor: %s\n", strings.Join(counts, ", "))
+}
+
+func (p *TtyPrinter) syntaxHighlight(text, path, lang string) (string, error) {
+	// cspell:words Tokenise
+
+	// Resolve the lexer.
+	l := chroma.Coalesce(resolveLexer(text, path, lang))
 
 	// Resolve the formatter.
 	f := formatters.TTY256
@@ -325,7 +1175,7 @@ func (p *TtyPrinter) syntaxHighlight(text, path, lang string) (string, error) {
 }
 
 // Copied from golangci-lint.
-func (p *TtyPrinter) printUnderLinePointer(result *Result, formatter *ui.Formatter) {
+func (p *TtyPrinter) printUnderLinePointer(result *Result, gutter string, formatter *ui.Formatter) {
 	// StartColumn == 0 means "unknown".
 	if len(result.ContextLines) != 1 || result.Location.StartColumn == 0 {
 		return
@@ -353,5 +1203,27 @@ func (p *TtyPrinter) printUnderLinePointer(result *Result, formatter *ui.Formatt
 		indicatorRunes = append(indicatorRunes, '^')
 	}
 
-	fmt.Fprintf(p.ios.Out, "%s%s\n", string(prefixRunes), formatter.Yellow(string(indicatorRunes)))
+	fmt.Fprintf(
+		p.ios.Out, "%s%s%s%s\n",
+		p.indent, gutter, string(prefixRunes), formatter.Yellow(string(indicatorRunes)),
+	)
+}
+
+// resolveLexer returns the chroma lexer for the given language,
+// falling back to the file path and then the content.
+func resolveLexer(text, path, lang string) chroma.Lexer { //nolint:ireturn
+	// seems the chroma author uses UK english :/
+	// cspell:words Analyse
+
+	l := lexers.Get(lang)
+	if l == nil {
+		l = lexers.Match(path)
+	}
+	if l == nil {
+		l = lexers.Analyse(text)
+	}
+	if l == nil {
+		l = lexers.Fallback
+	}
+	return l
 }
@@ -3,6 +3,7 @@ package stylist
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -16,13 +17,19 @@ func TestNewResultPrinter(t *testing.T) {
 	for _, name := range ResultFormatNames() {
 		config.Output.Format = ResultFormat(name)
 		assert.NotPanics(t, func() {
-			_ = NewResultPrinter(ios, config)
+			_ = NewResultPrinter(ios, config, &RunInfo{})
 		})
 	}
 	assert.PanicsWithValue(t, "unknown result format: unknown", func() {
 		config.Output.Format = ResultFormat("unknown")
-		_ = NewResultPrinter(ios, config)
+		_ = NewResultPrinter(ios, config, &RunInfo{})
 	})
+
+	// The TTY printer reports the time elapsed since the run started.
+	startedAt := time.Now().Add(-time.Minute)
+	config.Output.Format = ResultFormatTty
+	printer := NewResultPrinter(ios, config, &RunInfo{StartedAt: startedAt})
+	assert.Equal(t, startedAt, printer.(*TtyPrinter).startedAt)
 }
 
 func TestCheckstylePrinter_Print(t *testing.T) {
@@ -112,6 +119,118 @@ func TestCheckstylePrinter_Print(t *testing.T) {
 	}
 }
 
+func TestHTMLPrinter_Print(t *testing.T) {
+	results := []*Result{
+		{
+			Source: "test-linter",
+			Level:  ResultLevelError,
+			Location: ResultLocation{
+				Path:        "some/path/foo.go",
+				StartLine:   3,
+				StartColumn: 1,
+			},
+			Rule: ResultRule{
+				ID:          "rule-id1",
+				Description: "bad <code>",
+				URI:         "https://example.com/rule-id1",
+			},
+			ContextLines: []string{
+				"package foo",
+			},
+			ContextLang: "go",
+		},
+		{
+			Source: "other-linter",
+			Level:  ResultLevelWarning,
+			Location: ResultLocation{
+				Path:      "some/path/bar.go",
+				StartLine: 4,
+			},
+			Rule: ResultRule{
+				ID:          "rule-id2",
+				Description: "no uri",
+				URI:         "javascript:alert(1)",
+			},
+		},
+	}
+
+	tests := []struct {
+		desc     string
+		config   OutputConfig
+		results  []*Result
+		contains []string
+		excludes []string
+	}{
+		{
+			desc:    "empty result set should print a success message",
+			results: []*Result{},
+			contains: []string{
+				"<!DOCTYPE html>",
+				"<p>No issues found.</p>",
+			},
+			excludes: []string{
+				"<details",
+			},
+		},
+		{
+			desc: "a non empty result set should print a report",
+			config: OutputConfig{
+				ShowContext:     true,
+				SyntaxHighlight: true,
+			},
+			results: results,
+			contains: []string{
+				"<p>2 issue(s) in 2 file(s).</p>",
+				`<option value="other-linter">other-linter</option>`,
+				`<option value="error">error</option>`,
+				`<option value="test-linter/rule-id1">test-linter/rule-id1</option>`,
+				"<summary><code>some/path/foo.go</code>",
+				`data-source="test-linter" data-level="error" data-rule="test-linter/rule-id1"`,
+				"bad &lt;code&gt;",
+				`<a href="https://example.com/rule-id1" target="_blank" rel="noopener">rule-id1</a>`,
+				`<span class="ln">3</span>`,
+				`<span class="kn">package</span>`,
+				`href="#ZgotmplZ"`,
+			},
+		},
+		{
+			desc: "should not include context if disabled",
+			config: OutputConfig{
+				ShowContext: false,
+			},
+			results: results,
+			contains: []string{
+				"<summary><code>some/path/foo.go</code>",
+			},
+			excludes: []string{
+				`<div class="context">`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			app := NewTestApp()
+			app.Config.Output = tt.config
+
+			printer := &HTMLPrinter{
+				ios:    app.IO,
+				config: app.Config,
+			}
+			err := printer.Print(tt.results)
+			require.NoError(t, err)
+
+			out := app.IO.Out.String()
+			for _, s := range tt.contains {
+				assert.Contains(t, out, s)
+			}
+			for _, s := range tt.excludes {
+				assert.NotContains(t, out, s)
+			}
+		})
+	}
+}
+
 func TestJSONPrinter_Print(t *testing.T) {
 	results := []*Result{
 		{
@@ -223,6 +342,319 @@ func TestJSONPrinter_Print(t *testing.T) {
 	}
 }
 
+func TestJUnitPrinter_Print(t *testing.T) {
+	results := []*Result{
+		{
+			Source: "test-linter",
+			Level:  ResultLevelWarning,
+			Location: ResultLocation{
+				Path:        "some/path/foo.go",
+				StartLine:   1,
+				StartColumn: 2,
+			},
+			Rule: ResultRule{
+				ID:          "rule-id1",
+				Description: "first issue",
+			},
+		},
+		{
+			Source: "test-linter",
+			Level:  ResultLevelError,
+			Location: ResultLocation{
+				Path:        "some/path/foo.go",
+				StartLine:   3,
+				StartColumn: 4,
+			},
+			Rule: ResultRule{
+				ID:          "rule-id2",
+				Description: "second issue",
+			},
+		},
+		{
+			Source: "another-linter",
+			Level:  ResultLevelInfo,
+			Location: ResultLocation{
+				Path:      "some/path/bar.go",
+				StartLine: 5,
+			},
+			Rule: ResultRule{
+				Description: "third issue",
+			},
+		},
+	}
+
+	tests := []struct {
+		desc     string
+		results  []*Result
+		run      *RunInfo
+		expected string
+		err      string
+	}{
+		{
+			desc:     "empty result set should print an empty junit doc",
+			results:  []*Result{},
+			expected: `<?xml version="1.0" encoding="UTF-8"?><testsuites tests="0" failures="0"></testsuites>`,
+		},
+		{
+			desc:    "checked files without results should be passing test cases",
+			results: []*Result{},
+			run: &RunInfo{
+				Paths: map[string][]string{
+					"test-linter":  {"some/path/foo.go", "some/path/bar.go"},
+					"other-linter": {},
+				},
+			},
+			expected: `<?xml version="1.0" encoding="UTF-8"?><testsuites tests="3" failures="0"><testsuite name="other-linter" tests="1" failures="0"><testcase name="other-linter" classname="other-linter"></testcase></testsuite><testsuite name="test-linter" tests="2" failures="0"><testcase name="some/path/bar.go" classname="test-linter"></testcase><testcase name="some/path/foo.go" classname="test-linter"></testcase></testsuite></testsuites>`, //nolint: lll
+		},
+		{
+			desc:    "checked files with results should be failing test cases",
+			results: results,
+			run: &RunInfo{
+				Paths: map[string][]string{
+					"test-linter":    {"some/path/foo.go", "some/path/baz.go"},
+					"another-linter": {"some/path/bar.go"},
+				},
+			},
+			expected: `<?xml version="1.0" encoding="UTF-8"?><testsuites tests="3" failures="2"><testsuite name="another-linter" tests="1" failures="1"><testcase name="some/path/bar.go" classname="another-linter"><failure message="1 issue(s)" type="info">some/path/bar.go:5:0: info: third issue&#xA;</failure></testcase></testsuite><testsuite name="test-linter" tests="2" failures="1"><testcase name="some/path/baz.go" classname="test-linter"></testcase><testcase name="some/path/foo.go" classname="test-linter"><failure message="2 issue(s)" type="error">some/path/foo.go:1:2: warning: first issue [rule-id1]&#xA;some/path/foo.go:3:4: error: second issue [rule-id2]&#xA;</failure></testcase></testsuite></testsuites>`, //nolint: lll
+		},
+		{
+			desc:     "a non empty result set should print junit formatted results",
+			results:  results,
+			expected: `<?xml version="1.0" encoding="UTF-8"?><testsuites tests="2" failures="2"><testsuite name="another-linter" tests="1" failures="1"><testcase name="some/path/bar.go" classname="another-linter"><failure message="1 issue(s)" type="info">some/path/bar.go:5:0: info: third issue&#xA;</failure></testcase></testsuite><testsuite name="test-linter" tests="1" failures="1"><testcase name="some/path/foo.go" classname="test-linter"><failure message="2 issue(s)" type="error">some/path/foo.go:1:2: warning: first issue [rule-id1]&#xA;some/path/foo.go:3:4: error: second issue [rule-id2]&#xA;</failure></testcase></testsuite></testsuites>`, //nolint: lll
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			app := NewTestApp()
+			printer := &JUnitPrinter{
+				ios:    app.IO,
+				config: app.Config,
+				run:    tt.run,
+			}
+			err := printer.Print(tt.results)
+
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.err)
+			}
+
+			out := app.IO.Out.String()
+			out = strings.ReplaceAll(out, "\n", "")
+			assert.Equal(t, tt.expected, out)
+		})
+	}
+}
+
+func TestMarkdownPrinter_Print(t *testing.T) {
+	results := []*Result{
+		{
+			Source: "test-linter",
+			Level:  ResultLevelError,
+			Location: ResultLocation{
+				Path:        "some/path/foo.go",
+				StartLine:   1,
+				StartColumn: 2,
+			},
+			Rule: ResultRule{
+				ID:          "rule-id1",
+				Description: "uses a | pipe",
+				URI:         "https://example.com/",
+			},
+			ContextLines: []string{
+				"foo1",
+			},
+			ContextLang: "go",
+		},
+		{
+			Source: "test-linter",
+			Level:  ResultLevelWarning,
+			Location: ResultLocation{
+				Path:      "some/path/foo.go",
+				StartLine: 2,
+			},
+			Rule: ResultRule{
+				ID:          "rule-id2",
+				Description: "no uri",
+			},
+		},
+		{
+			Source: "other-linter",
+			Level:  ResultLevelInfo,
+			Location: ResultLocation{
+				Path:      "some/path/bar.go",
+				StartLine: 4,
+			},
+			Rule: ResultRule{
+				Description: "no rule id",
+			},
+		},
+	}
+
+	tests := []struct {
+		desc     string
+		config   OutputConfig
+		results  []*Result
+		expected []string
+	}{
+		{
+			desc:    "empty result set should print a success message",
+			results: []*Result{},
+			expected: []string{
+				"## Stylist results",
+				"",
+				"No issues found.",
+			},
+		},
+		{
+			desc: "a non empty result set should print a summary and file sections",
+			config: OutputConfig{
+				ShowContext: true,
+			},
+			results: results,
+			expected: []string{
+				"## Stylist results",
+				"",
+				"| Processor | error | warning | info | none | total |",
+				"| --- | ---: | ---: | ---: | ---: | ---: |",
+				"| other-linter | 0 | 0 | 1 | 0 | 1 |",
+				"| test-linter | 1 | 1 | 0 | 0 | 2 |",
+				"| **Total** | 1 | 1 | 1 | 0 | 3 |",
+				"",
+				"<details>",
+				"<summary><code>some/path/foo.go</code> (2 issue(s))</summary>",
+				"",
+				"- **error** test-linter [`rule-id1`](https://example.com/): uses a \\| pipe (`some/path/foo.go:1:2`)",
+				"",
+				"  ```go",
+				"  foo1",
+				"  ```",
+				"",
+				"- **warning** test-linter `rule-id2`: no uri (`some/path/foo.go:2:0`)",
+				"",
+				"</details>",
+				"",
+				"<details>",
+				"<summary><code>some/path/bar.go</code> (1 issue(s))</summary>",
+				"",
+				"- **info** other-linter: no rule id (`some/path/bar.go:4:0`)",
+				"",
+				"</details>",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			app := NewTestApp()
+			app.Config.Output = tt.config
+
+			printer := &MarkdownPrinter{
+				ios:    app.IO,
+				config: app.Config,
+			}
+			err := printer.Print(tt.results)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, app.IO.Out.Lines())
+		})
+	}
+}
+
+func TestMarkdownFence(t *testing.T) {
+	assert.Equal(t, "```", markdownFence([]string{"foo"}))
+	assert.Equal(t, "````", markdownFence([]string{"```go", "foo"}))
+}
+
+func TestRDJSONPrinter_Print(t *testing.T) {
+	results := []*Result{
+		{
+			Source: "test-linter",
+			Level:  ResultLevelError,
+			Location: ResultLocation{
+				Path:        "some/path/foo.go",
+				StartLine:   1,
+				StartColumn: 2,
+				EndLine:     1,
+				EndColumn:   5,
+			},
+			Rule: ResultRule{
+				ID:          "rule-id1",
+				Description: "has a suggestion",
+				URI:         "https://example.com/",
+			},
+			Fixes: []*ResultFix{
+				{
+					Location: ResultLocation{
+						Path:        "some/path/foo.go",
+						StartLine:   1,
+						StartColumn: 2,
+						EndLine:     1,
+						EndColumn:   5,
+					},
+					Replacement: "bar",
+				},
+				{
+					ByteOffset:  10,
+					ByteLength:  2,
+					Replacement: "skipped",
+				},
+			},
+		},
+		{
+			Source: "test-linter",
+			Level:  ResultLevelNone,
+			Location: ResultLocation{
+				Path: "some/path/bar.go",
+			},
+			Rule: ResultRule{
+				Description: "no location",
+			},
+		},
+	}
+	diagnostics := []string{
+		`{"message":"has a suggestion","location":{"path":"some/path/foo.go","range":{"start":{"line":1,"column":2},"end":{"line":1,"column":5}}},"severity":"ERROR","source":{"name":"test-linter"},"code":{"value":"rule-id1","url":"https://example.com/"},"suggestions":[{"range":{"start":{"line":1,"column":2},"end":{"line":1,"column":5}},"text":"bar"}]}`, //nolint: lll
+		`{"message":"no location","location":{"path":"some/path/bar.go"},"severity":"UNKNOWN_SEVERITY","source":{"name":"test-linter"}}`, //nolint: lll
+	}
+
+	t.Run("rdjson empty", func(t *testing.T) {
+		app := NewTestApp()
+		printer := &RDJSONPrinter{
+			ios:    app.IO,
+			config: app.Config,
+		}
+
+		require.NoError(t, printer.Print([]*Result{}))
+		assert.Equal(t, `{"source":{"name":"stylist"},"diagnostics":[]}`+"\n", app.IO.Out.String())
+	})
+
+	t.Run("rdjson", func(t *testing.T) {
+		app := NewTestApp()
+		printer := &RDJSONPrinter{
+			ios:    app.IO,
+			config: app.Config,
+		}
+
+		require.NoError(t, printer.Print(results))
+		assert.Equal(t,
+			`{"source":{"name":"stylist"},"diagnostics":[`+strings.Join(diagnostics, ",")+"]}\n",
+			app.IO.Out.String(),
+		)
+	})
+
+	t.Run("rdjsonl", func(t *testing.T) {
+		app := NewTestApp()
+		printer := &RDJSONLPrinter{
+			ios:    app.IO,
+			config: app.Config,
+		}
+
+		require.NoError(t, printer.Print(results))
+		assert.Equal(t, diagnostics, app.IO.Out.Lines())
+	})
+}
+
 func TestSarifPrinter_Print(t *testing.T) {
 	results := []*Result{
 		{
@@ -332,6 +764,186 @@ func TestSarifPrinter_Print(t *testing.T) {
 	}
 }
 
+func TestSarifPrinter_Print_ContextRegion(t *testing.T) {
+	app := NewTestApp()
+	app.Config.Output.ShowContext = true
+
+	printer := &SarifPrinter{
+		ios:    app.IO,
+		config: app.Config,
+	}
+	err := printer.Print([]*Result{
+		{
+			Source: "test-linter",
+			Level:  ResultLevelError,
+			Location: ResultLocation{
+				Path:      "foo.go",
+				StartLine: 9,
+			},
+			Rule:          ResultRule{ID: "rule-id1", Description: "some issue"},
+			ContextLines:  []string{"line nine"},
+			ContextBefore: []string{"line seven", "line eight"},
+			ContextAfter:  []string{},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Contains(t, app.IO.Out.String(),
+		`"contextRegion":{"startLine":7,"endLine":9,"snippet":{"text":"line seven\nline eight\nline nine"}}`,
+	)
+}
+
+func TestSarifPrinter_Print_RelatedLocations(t *testing.T) {
+	app := NewTestApp()
+
+	related := []*ResultRelatedLocation{
+		{
+			Location: ResultLocation{Path: "bar.go", StartLine: 2, StartColumn: 4},
+			Message:  "defined here",
+		},
+	}
+	flows := []*ResultCodeFlow{
+		{
+			Message: "flows to sink",
+			Locations: []*ResultRelatedLocation{
+				{Location: ResultLocation{Path: "bar.go", StartLine: 2}, Message: "source"},
+				{Location: ResultLocation{Path: "foo.go", StartLine: 9}, Message: "sink"},
+			},
+		},
+	}
+
+	printer := &SarifPrinter{
+		ios:    app.IO,
+		config: app.Config,
+	}
+	err := printer.Print([]*Result{
+		{
+			Source:           "test-linter",
+			Level:            ResultLevelError,
+			Location:         ResultLocation{Path: "foo.go", StartLine: 9},
+			Rule:             ResultRule{ID: "rule-id1", Description: "some issue"},
+			RelatedLocations: related,
+			CodeFlows:        flows,
+		},
+	})
+	require.NoError(t, err)
+
+	// Printed SARIF should round trip through the parser.
+	results, err := ParseOutput(&SarifOutputParser{}, CommandOutput{
+		Content: strings.NewReader(app.IO.Out.String()),
+	}, ResultMapping{})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, related, results[0].RelatedLocations)
+	assert.Equal(t, flows, results[0].CodeFlows)
+}
+
+func TestTemplatePrinter_Print(t *testing.T) {
+	results := []*Result{
+		{
+			Source: "test-linter",
+			Level:  ResultLevelError,
+			Location: ResultLocation{
+				Path:        "some/path/foo.go",
+				StartLine:   1,
+				StartColumn: 2,
+			},
+			Rule: ResultRule{
+				ID:          "rule-id1",
+				Description: `has "quotes"`,
+			},
+		},
+		{
+			Source: "other-linter",
+			Level:  ResultLevelWarning,
+			Location: ResultLocation{
+				Path:      "some/path/foo.go",
+				StartLine: 3,
+			},
+			Rule: ResultRule{
+				ID:          "rule-id2",
+				Description: "second issue",
+			},
+		},
+		{
+			Source: "test-linter",
+			Level:  ResultLevelWarning,
+			Location: ResultLocation{
+				Path:      "some/path/bar.go",
+				StartLine: 4,
+			},
+			Rule: ResultRule{
+				ID:          "rule-id1",
+				Description: "third issue",
+			},
+		},
+	}
+
+	tests := []struct {
+		desc     string
+		template string
+		expected []string
+		err      string
+	}{
+		{
+			desc:     "returns an error when no template",
+			template: "",
+			err:      "output template is required",
+		},
+		{
+			desc:     "returns an error when the template is invalid",
+			template: "{{ .Nope",
+			err:      "invalid output template",
+		},
+		{
+			desc:     "returns an error for unknown keys",
+			template: `{{ groupBy "nope" . }}`,
+			err:      "unknown result key: nope",
+		},
+		{
+			desc:     "renders inline templates",
+			template: `{{ range . }}{{ .Location }} {{ .Level }} {{ jsonEscape .Rule.Description }}{{ "\n" }}{{ end }}`,
+			expected: []string{
+				`some/path/foo.go:1:2 error has \"quotes\"`,
+				`some/path/foo.go:3:0 warning second issue`,
+				`some/path/bar.go:4:0 warning third issue`,
+			},
+		},
+		{
+			desc:     "renders template files",
+			template: "testdata/templates/summary.tmpl",
+			expected: []string{
+				"3 issue(s)",
+				"error: 1",
+				"warning: 2",
+				"some/path/bar.go: 1",
+				"some/path/foo.go: 2",
+				"other-linter: 1",
+				"test-linter: 2",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			app := NewTestApp()
+			app.Config.Output.Template = tt.template
+
+			printer := &TemplatePrinter{
+				ios:    app.IO,
+				config: app.Config,
+			}
+			err := printer.Print(results)
+
+			if tt.err == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, app.IO.Out.Lines())
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
+
 func TestTtyPrinter_Print(t *testing.T) {
 	results := []*Result{
 		{
@@ -482,6 +1094,54 @@ func TestTtyPrinter_Print(t *testing.T) {
 			},
 			err: "",
 		},
+		{
+			desc: "should group results by file",
+			config: OutputConfig{
+				GroupBy: ResultGroupFile,
+			},
+			results: results[0:2],
+			expected: []string{
+				"some/path/foo.go",
+				"  1:0: error: test-linter: no start column. [rule-id1]",
+				"",
+				"some/path/bar.go",
+				"  2:10: warning: test-linter: valid start and end column. [rule-id2]",
+			},
+			err: "",
+		},
+		{
+			desc: "should group results by source",
+			config: OutputConfig{
+				GroupBy:     ResultGroupSource,
+				ShowContext: true,
+			},
+			results: results[0:2],
+			expected: []string{
+				"test-linter",
+				"  some/path/foo.go:1:0: error: no start column. [rule-id1]",
+				"  context line one",
+				"  some/path/bar.go:2:10: warning: valid start and end column. [rule-id2]",
+				"  \tcontext line two",
+				"  \t        ^^^^",
+			},
+			err: "",
+		},
+		{
+			desc: "should group results by rule",
+			config: OutputConfig{
+				GroupBy: ResultGroupRule,
+				ShowURL: true,
+			},
+			results: results[0:2],
+			expected: []string{
+				"test-linter/rule-id1 (https://test-linter.com/rule-id1)",
+				"  some/path/foo.go:1:0: error: no start column.",
+				"",
+				"test-linter/rule-id2 (https://test-linter.com/rule-id2)",
+				"  some/path/bar.go:2:10: warning: valid start and end column.",
+			},
+			err: "",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.desc, func(t *testing.T) {
@@ -505,6 +1165,95 @@ func TestTtyPrinter_Print(t *testing.T) {
 	}
 }
 
+func TestTtyPrinter_Print_SurroundingContext(t *testing.T) {
+	app := NewTestApp()
+	app.Config.Output.ShowContext = true
+	app.Config.Output.ShowURL = false
+
+	printer := &TtyPrinter{
+		ios:    app.IO,
+		config: app.Config,
+	}
+	err := printer.Print([]*Result{
+		{
+			Source: "test-linter",
+			Level:  ResultLevelError,
+			Location: ResultLocation{
+				Path:        "foo.go",
+				StartLine:   9,
+				StartColumn: 3,
+				EndLine:     9,
+				EndColumn:   6,
+			},
+			Rule:          ResultRule{ID: "rule-id1", Description: "some issue"},
+			ContextLines:  []string{"line nine"},
+			ContextBefore: []string{"line eight"},
+			ContextAfter:  []string{"line ten"},
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"foo.go:9:3: error: test-linter: some issue. [rule-id1]",
+		"   8 | line eight",
+		">  9 | line nine",
+		"         ^^^",
+		"  10 | line ten",
+	}, app.IO.Out.Lines())
+}
+
+func TestTtyPrinter_Print_Summary(t *testing.T) {
+	app := NewTestApp()
+	app.Config.Output.ShowContext = false
+	app.Config.Output.ShowURL = false
+	app.Config.Output.ShowSummary = true
+
+	printer := &TtyPrinter{
+		ios:       app.IO,
+		config:    app.Config,
+		startedAt: time.Now().Add(-time.Hour),
+	}
+	err := printer.Print([]*Result{
+		{
+			Source:   "test-linter",
+			Level:    ResultLevelWarning,
+			Location: ResultLocation{Path: "foo.go", StartLine: 1, StartColumn: 1},
+			Rule:     ResultRule{ID: "rule-id1", Description: "first"},
+		},
+		{
+			Source:   "other-linter",
+			Level:    ResultLevelError,
+			Location: ResultLocation{Path: "foo.go", StartLine: 2, StartColumn: 1},
+			Rule:     ResultRule{ID: "rule-id2", Description: "second"},
+		},
+		{
+			Source:   "test-linter",
+			Level:    ResultLevelWarning,
+			Location: ResultLocation{Path: "bar.go", StartLine: 3, StartColumn: 1},
+			Rule:     ResultRule{ID: "rule-id1", Description: "third"},
+		},
+	})
+	require.NoError(t, err)
+
+	lines := app.IO.Out.Lines()
+	require.Len(t, lines, 7)
+	assert.Equal(t, "", lines[3])
+	assert.Regexp(t, `^3 issue\(s\) in 2 file\(s\) \(1h0m0(\.\d+)?s\)$`, lines[4])
+	assert.Equal(t, "  severity: error: 1, warning: 2", lines[5])
+	assert.Equal(t, "  processor: other-linter: 1, test-linter: 2", lines[6])
+
+	// Only the totals are printed when there are no results,
+	// and the elapsed time only when the start time is known.
+	app = NewTestApp()
+	app.Config.Output.ShowSummary = true
+	printer = &TtyPrinter{
+		ios:    app.IO,
+		config: app.Config,
+	}
+	require.NoError(t, printer.Print([]*Result{}))
+	assert.Equal(t, []string{"0 issue(s) in 0 file(s)"}, app.IO.Out.Lines())
+}
+
 func TestTtyPrinter_Print_ColorEnabled(t *testing.T) {
 	app := NewTestApp()
 
@@ -27,6 +27,34 @@ func TestResultLocation_String(t *testing.T) {
 	assert.Equal(t, "foo/bar.go:10:12", loc.String())
 }
 
+func TestResult_SecondaryLocations(t *testing.T) {
+	result := &Result{
+		RelatedLocations: []*ResultRelatedLocation{
+			{Location: ResultLocation{Path: "aaa.go"}},
+		},
+		CodeFlows: []*ResultCodeFlow{
+			{
+				Locations: []*ResultRelatedLocation{
+					{Location: ResultLocation{Path: "bbb.go"}},
+					{Location: ResultLocation{Path: "ccc.go"}},
+				},
+			},
+		},
+	}
+	assert.Empty(t, (&Result{}).SecondaryLocations())
+
+	locations := result.SecondaryLocations()
+	assert.Len(t, locations, 3)
+
+	// Updating the returned locations should update the result.
+	for _, loc := range locations {
+		loc.Path = "dir/" + loc.Path
+	}
+	assert.Equal(t, "dir/aaa.go", result.RelatedLocations[0].Location.Path)
+	assert.Equal(t, "dir/bbb.go", result.CodeFlows[0].Locations[0].Location.Path)
+	assert.Equal(t, "dir/ccc.go", result.CodeFlows[0].Locations[1].Location.Path)
+}
+
 func TestNewResultsError(t *testing.T) {
 	var err error
 
@@ -45,6 +73,70 @@ func TestNewResultsError(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestNewThresholdResultsError(t *testing.T) {
+	results := []*Result{
+		{Source: "test-linter", Level: ResultLevelInfo},
+		{Source: "test-linter", Level: ResultLevelWarning},
+		{Source: "test-linter", Level: ResultLevelWarning},
+	}
+
+	tests := []struct {
+		desc        string
+		results     []*Result
+		failLevel   ResultLevel
+		maxWarnings int
+		err         string
+	}{
+		{
+			desc:        "returns nil when there are no results",
+			results:     nil,
+			failLevel:   ResultLevelNone,
+			maxWarnings: -1,
+			err:         "",
+		},
+		{
+			desc:        "returns an error for results at or above the fail level",
+			results:     results,
+			failLevel:   ResultLevelWarning,
+			maxWarnings: -1,
+			err:         "2 issue(s)",
+		},
+		{
+			desc:        "returns nil when all results are below the fail level",
+			results:     results,
+			failLevel:   ResultLevelError,
+			maxWarnings: -1,
+			err:         "",
+		},
+		{
+			desc:        "returns nil when warnings are within the maximum",
+			results:     results,
+			failLevel:   ResultLevelError,
+			maxWarnings: 2,
+			err:         "",
+		},
+		{
+			desc:        "returns an error when warnings exceed the maximum",
+			results:     results,
+			failLevel:   ResultLevelError,
+			maxWarnings: 1,
+			err:         "2 warning(s) exceeds the maximum of 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := NewThresholdResultsError(tt.results, tt.failLevel, tt.maxWarnings)
+
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+				assert.IsType(t, &ResultsError{}, err)
+			}
+		})
+	}
+}
+
 func TestResultsError_Error(t *testing.T) {
 	var err error
 
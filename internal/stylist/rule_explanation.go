@@ -0,0 +1,123 @@
+package stylist
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/shlex"
+)
+
+// RuleExplanation describes a single rule reported by a processor.
+type RuleExplanation struct {
+	Source string
+	Rule   ResultRule
+}
+
+// ruleTemplateData is the data used to render the
+// processor rule URI and explain command templates.
+type ruleTemplateData struct {
+	Source   string
+	RuleID   string
+	RuleName string
+}
+
+func (p *Processor) ruleTemplateData(rule ResultRule) ruleTemplateData {
+	name := rule.Name
+	if name == "" {
+		name = rule.ID
+	}
+	return ruleTemplateData{
+		Source:   p.Name,
+		RuleID:   rule.ID,
+		RuleName: name,
+	}
+}
+
+// RuleURIFor renders the processor rule URI template for the given rule.
+// Returns an empty string if the processor has no rule URI template.
+func (p *Processor) RuleURIFor(rule ResultRule) (string, error) {
+	if p.RuleURI == nil {
+		return "", nil
+	}
+	uri, err := p.RuleURI.Render(p.ruleTemplateData(rule))
+	if err != nil {
+		return "", fmt.Errorf("invalid rule_uri for %s: %w", p.Name, err)
+	}
+	return strings.TrimSpace(uri), nil
+}
+
+// ExplainRule returns the explanation for the given rule ID.
+// When the processor has an explain command, its output
+// is used as the rule description.
+func (p *Processor) ExplainRule(ctx context.Context, ruleID string) (*RuleExplanation, error) {
+	rule := ResultRule{
+		ID:   ruleID,
+		Name: ruleID,
+	}
+	uri, err := p.RuleURIFor(rule)
+	if err != nil {
+		return nil, err
+	}
+	rule.URI = uri
+
+	explanation := &RuleExplanation{
+		Source: p.Name,
+		Rule:   rule,
+	}
+	if p.ExplainCommand == nil {
+		return explanation, nil
+	}
+
+	rendered, err := p.ExplainCommand.Render(p.ruleTemplateData(rule))
+	if err != nil {
+		return nil, fmt.Errorf("invalid explain_command for %s: %w", p.Name, err)
+	}
+	args, err := shlex.Split(rendered)
+	if err != nil {
+		return nil, err
+	}
+	if len(args) == 0 {
+		return nil, ErrCommandEmpty
+	}
+
+	cmd := AppCmdClient(ctx).CommandContext(ctx, args[0], args[1:]...)
+	stdout := &bytes.Buffer{}
+	cmd.Stdout = stdout
+	AppLogger(ctx).Debugln("Command:", cmd.String())
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("explain command failed: %w", err)
+	}
+	explanation.Rule.Description = strings.TrimRight(stdout.String(), "\n")
+
+	return explanation, nil
+}
+
+// ResolveRuleURIs returns a transformer that fills in missing rule URIs
+// using the rule URI template of the processor that reported the result.
+func ResolveRuleURIs(processors []*Processor) ResultsTransformer {
+	return func(ctx context.Context, results []*Result) ([]*Result, error) {
+		bySource := map[string]*Processor{}
+		for _, p := range processors {
+			bySource[p.Name] = p
+		}
+
+		for _, r := range results {
+			if r.Rule.URI != "" {
+				continue
+			}
+			p, ok := bySource[r.Source]
+			if !ok {
+				continue
+			}
+			uri, err := p.RuleURIFor(r.Rule)
+			if err != nil {
+				return nil, err
+			}
+			r.Rule.URI = uri
+		}
+
+		return results, nil
+	}
+}
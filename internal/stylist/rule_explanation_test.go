@@ -0,0 +1,171 @@
+package stylist
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twelvelabs/termite/render"
+	"github.com/twelvelabs/termite/run"
+)
+
+func TestProcessor_RuleURIFor(t *testing.T) {
+	tests := []struct {
+		desc      string
+		processor *Processor
+		rule      ResultRule
+		expected  string
+		err       string
+	}{
+		{
+			desc:      "returns empty string when no template",
+			processor: &Processor{Name: "linter"},
+			rule:      ResultRule{ID: "rule-a"},
+			expected:  "",
+		},
+		{
+			desc: "renders the template",
+			processor: &Processor{
+				Name:    "linter",
+				RuleURI: render.MustCompile("https://example.com/{{ .Source }}/{{ .RuleID }}"),
+			},
+			rule:     ResultRule{ID: "rule-a"},
+			expected: "https://example.com/linter/rule-a",
+		},
+		{
+			desc: "returns an error for malformed templates",
+			processor: &Processor{
+				Name:    "linter",
+				RuleURI: render.MustCompile("{{ .Unknown.Field }}"),
+			},
+			rule: ResultRule{ID: "rule-a"},
+			err:  "invalid rule_uri for linter",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			actual, err := tt.processor.RuleURIFor(tt.rule)
+
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestProcessor_ExplainRule(t *testing.T) {
+	tests := []struct {
+		desc      string
+		processor *Processor
+		setup     func(c *run.Client)
+		expected  *RuleExplanation
+		err       string
+	}{
+		{
+			desc: "returns the rule URI",
+			processor: &Processor{
+				Name:    "linter",
+				RuleURI: render.MustCompile("https://example.com/{{ .RuleID }}"),
+			},
+			expected: &RuleExplanation{
+				Source: "linter",
+				Rule: ResultRule{
+					ID:   "rule-a",
+					Name: "rule-a",
+					URI:  "https://example.com/rule-a",
+				},
+			},
+		},
+		{
+			desc: "uses the explain command output as the description",
+			processor: &Processor{
+				Name:           "linter",
+				ExplainCommand: render.MustCompile("linter explain {{ .RuleID }}"),
+			},
+			setup: func(c *run.Client) {
+				c.RegisterStub(
+					run.MatchString("linter explain rule-a"),
+					run.StringResponse("Rule A does things.\n"),
+				)
+			},
+			expected: &RuleExplanation{
+				Source: "linter",
+				Rule: ResultRule{
+					ID:          "rule-a",
+					Name:        "rule-a",
+					Description: "Rule A does things.",
+				},
+			},
+		},
+		{
+			desc: "returns an error when the explain command fails",
+			processor: &Processor{
+				Name:           "linter",
+				ExplainCommand: render.MustCompile("linter explain {{ .RuleID }}"),
+			},
+			setup: func(c *run.Client) {
+				c.RegisterStub(
+					run.MatchString("linter explain rule-a"),
+					run.ErrorResponse(errors.New("boom")),
+				)
+			},
+			err: "explain command failed: boom",
+		},
+		{
+			desc: "returns an error when the explain command is empty",
+			processor: &Processor{
+				Name:           "linter",
+				ExplainCommand: render.MustCompile(""),
+			},
+			err: ErrCommandEmpty.Error(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			app := NewTestApp()
+			client := app.CmdClient
+			if tt.setup != nil {
+				tt.setup(client)
+			}
+			ctx := app.InitContext(context.Background())
+
+			actual, err := tt.processor.ExplainRule(ctx, "rule-a")
+
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.err)
+			}
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestResolveRuleURIs(t *testing.T) {
+	processors := []*Processor{
+		{
+			Name:    "linter",
+			RuleURI: render.MustCompile("https://example.com/{{ .RuleID }}"),
+		},
+	}
+	results := []*Result{
+		{Source: "linter", Rule: ResultRule{ID: "rule-a"}},
+		{Source: "linter", Rule: ResultRule{ID: "rule-b", URI: "https://other.com/rule-b"}},
+		{Source: "other", Rule: ResultRule{ID: "rule-c"}},
+	}
+
+	ctx := NewTestApp().InitContext(context.Background())
+	actual, err := ResolveRuleURIs(processors)(ctx, results)
+
+	require.NoError(t, err)
+	assert.Equal(t, []*Result{
+		{Source: "linter", Rule: ResultRule{ID: "rule-a", URI: "https://example.com/rule-a"}},
+		{Source: "linter", Rule: ResultRule{ID: "rule-b", URI: "https://other.com/rule-b"}},
+		{Source: "other", Rule: ResultRule{ID: "rule-c"}},
+	}, actual)
+}
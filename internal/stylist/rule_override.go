@@ -0,0 +1,110 @@
+package stylist
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+// ruleLevelOff is a special rule level used to disable a rule entirely.
+const ruleLevelOff = "off"
+
+// RuleOverride changes the severity level of (or disables) matching results.
+//
+// Rule is a glob pattern matched against "source/rule-id"
+// (i.e. "cspell/*" or "golangci-lint/errcheck").
+// Paths are optional glob patterns matched against the result path.
+// Level is one of the result levels, or "off" to drop the results entirely.
+type RuleOverride struct {
+	Rule  string   `yaml:"rule"`
+	Paths []string `yaml:"paths,omitempty"`
+	Level string   `yaml:"level"`
+}
+
+// Matches returns true if the override applies to the result.
+func (o *RuleOverride) Matches(r *Result, relPath string) (bool, error) {
+	ok, err := doublestar.Match(o.Rule, r.Source+"/"+r.Rule.ID)
+	if err != nil || !ok {
+		return false, err
+	}
+	if len(o.Paths) == 0 {
+		return true, nil
+	}
+	for _, pattern := range o.Paths {
+		ok, err := matchPattern(pattern, relPath)
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+	return false, nil
+}
+
+// OverrideRules returns a transformer that applies the rule overrides
+// configured for each processor (keyed by rule ID) and then the global
+// overrides from the config. When several overrides match a result,
+// the last one wins.
+func OverrideRules(processors []*Processor) ResultsTransformer {
+	return func(ctx context.Context, results []*Result) ([]*Result, error) {
+		config := AppConfig(ctx)
+		cwd, _ := os.Getwd()
+
+		// Processor overrides come first so that global ones take precedence.
+		overridesBySource := map[string][]*RuleOverride{}
+		for _, p := range processors {
+			overrides := []*RuleOverride{}
+			for _, rule := range slices.Sorted(maps.Keys(p.Rules)) {
+				overrides = append(overrides, &RuleOverride{
+					Rule:  p.Name + "/" + rule,
+					Level: p.Rules[rule],
+				})
+			}
+			overridesBySource[p.Name] = append(overrides, config.Rules...)
+		}
+
+		transformed := []*Result{}
+		for _, r := range results {
+			relPath := r.Location.Path
+			if filepath.IsAbs(relPath) {
+				relPath, _ = filepath.Rel(cwd, relPath)
+			}
+
+			overrides, ok := overridesBySource[r.Source]
+			if !ok {
+				overrides = config.Rules
+			}
+
+			level := ""
+			for _, o := range overrides {
+				ok, err := o.Matches(r, relPath)
+				if err != nil {
+					return nil, fmt.Errorf("invalid rule override %q: %w", o.Rule, err)
+				}
+				if ok {
+					level = o.Level
+				}
+			}
+
+			if level == "" {
+				transformed = append(transformed, r)
+				continue
+			}
+			if strings.EqualFold(level, ruleLevelOff) {
+				continue
+			}
+			parsed, err := ParseResultLevel(strings.ToLower(level))
+			if err != nil {
+				return nil, fmt.Errorf("invalid rule level: %w", err)
+			}
+			r.Level = parsed
+			transformed = append(transformed, r)
+		}
+
+		return transformed, nil
+	}
+}
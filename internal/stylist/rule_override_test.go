@@ -0,0 +1,164 @@
+package stylist
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuleOverride_Matches(t *testing.T) {
+	result := &Result{
+		Source: "golangci-lint",
+		Rule:   ResultRule{ID: "errcheck"},
+	}
+
+	tests := []struct {
+		desc     string
+		override *RuleOverride
+		expected bool
+		err      string
+	}{
+		{
+			desc:     "matches exact rules",
+			override: &RuleOverride{Rule: "golangci-lint/errcheck"},
+			expected: true,
+		},
+		{
+			desc:     "matches rule globs",
+			override: &RuleOverride{Rule: "golangci-*/*"},
+			expected: true,
+		},
+		{
+			desc:     "does not match other rules",
+			override: &RuleOverride{Rule: "golangci-lint/godot"},
+			expected: false,
+		},
+		{
+			desc:     "matches path globs",
+			override: &RuleOverride{Rule: "golangci-lint/*", Paths: []string{"other/**", "internal/**"}},
+			expected: true,
+		},
+		{
+			desc:     "does not match other paths",
+			override: &RuleOverride{Rule: "golangci-lint/*", Paths: []string{"other/**"}},
+			expected: false,
+		},
+		{
+			desc:     "returns an error for malformed globs",
+			override: &RuleOverride{Rule: "golangci-lint/[", Paths: []string{"other/**"}},
+			expected: false,
+			err:      "syntax error in pattern",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			actual, err := tt.override.Matches(result, "internal/foo.go")
+
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestOverrideRules(t *testing.T) {
+	newResult := func(source string, ruleID string, level ResultLevel) *Result {
+		return &Result{
+			Source:   source,
+			Level:    level,
+			Location: ResultLocation{Path: "internal/foo.go"},
+			Rule:     ResultRule{ID: ruleID},
+		}
+	}
+	processors := []*Processor{
+		{
+			Name: "markdownlint",
+			Rules: map[string]string{
+				"MD013": "off",
+				"MD026": "warning",
+			},
+		},
+		{
+			Name: "cspell",
+			Rules: map[string]string{
+				"spelling": "info",
+			},
+		},
+	}
+
+	tests := []struct {
+		desc     string
+		rules    []*RuleOverride
+		results  []*Result
+		expected []*Result
+		err      string
+	}{
+		{
+			desc: "applies processor rules",
+			results: []*Result{
+				newResult("markdownlint", "MD013", ResultLevelError),
+				newResult("markdownlint", "MD026", ResultLevelError),
+				newResult("markdownlint", "MD001", ResultLevelError),
+				newResult("cspell", "spelling", ResultLevelError),
+				newResult("other", "spelling", ResultLevelError),
+			},
+			expected: []*Result{
+				newResult("markdownlint", "MD026", ResultLevelWarning),
+				newResult("markdownlint", "MD001", ResultLevelError),
+				newResult("cspell", "spelling", ResultLevelInfo),
+				newResult("other", "spelling", ResultLevelError),
+			},
+		},
+		{
+			desc: "applies global rules after processor rules",
+			rules: []*RuleOverride{
+				{Rule: "*/spelling", Level: "warning"},
+				{Rule: "markdownlint/*", Paths: []string{"docs/**"}, Level: "off"},
+			},
+			results: []*Result{
+				newResult("markdownlint", "MD001", ResultLevelError),
+				newResult("cspell", "spelling", ResultLevelError),
+				newResult("other", "spelling", ResultLevelError),
+			},
+			expected: []*Result{
+				newResult("markdownlint", "MD001", ResultLevelError),
+				newResult("cspell", "spelling", ResultLevelWarning),
+				newResult("other", "spelling", ResultLevelWarning),
+			},
+		},
+		{
+			desc: "returns an error for unknown levels",
+			rules: []*RuleOverride{
+				{Rule: "*/*", Level: "severe"},
+			},
+			results: []*Result{
+				newResult("cspell", "spelling", ResultLevelError),
+			},
+			expected: nil,
+			err:      "invalid rule level",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			app := NewTestApp()
+			app.Config.Rules = tt.rules
+			ctx := app.InitContext(context.Background())
+
+			actual, err := OverrideRules(processors)(ctx, tt.results)
+
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.err)
+			}
+
+			require.Equal(t, tt.expected, actual)
+		})
+	}
+}
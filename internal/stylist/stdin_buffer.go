@@ -0,0 +1,36 @@
+package stylist
+
+import (
+	"context"
+
+	"github.com/twelvelabs/stylist/internal/fsutils"
+)
+
+// StdinBuffer holds the (possibly unsaved) contents of a file.
+// When set on the context, the contents are passed to processors via stdin
+// in place of the file on disk (i.e. so editors can check unsaved text).
+type StdinBuffer struct {
+	Path    string
+	Content []byte
+}
+
+// WithStdinBuffer returns a new context that passes buf to processors.
+func WithStdinBuffer(ctx context.Context, buf *StdinBuffer) context.Context {
+	return context.WithValue(ctx, ctxStdinBuffer, buf)
+}
+
+// StdinBufferFrom returns the stdin buffer set on ctx, or nil.
+func StdinBufferFrom(ctx context.Context) *StdinBuffer {
+	buf, _ := ctx.Value(ctxStdinBuffer).(*StdinBuffer)
+	return buf
+}
+
+// Returns a line cache that reads the contents of buf (if any)
+// in place of the file on disk.
+func newLineCache(buf *StdinBuffer) *fsutils.LineCache {
+	fileCache := fsutils.NewFileCache()
+	if buf != nil {
+		fileCache.SetFileBytes(buf.Path, buf.Content)
+	}
+	return fsutils.NewLineCache(fileCache)
+}
@@ -0,0 +1,17 @@
+package stylist
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStdinBufferFrom(t *testing.T) {
+	ctx := context.Background()
+	assert.Nil(t, StdinBufferFrom(ctx))
+
+	buf := &StdinBuffer{Path: "main.go", Content: []byte("package main\n")}
+	ctx = WithStdinBuffer(ctx, buf)
+	assert.Equal(t, buf, StdinBufferFrom(ctx))
+}
@@ -0,0 +1,124 @@
+package stylist
+
+import (
+	"regexp"
+	"strings"
+
+	mapset "github.com/deckarep/golang-set/v2"
+
+	"github.com/twelvelabs/stylist/internal/fsutils"
+)
+
+var (
+	// Matches `stylist:ignore`, `stylist:ignore[source/rule-id, ...]`
+	// and the `stylist:ignore-file` variants of both.
+	// The directive must start a comment (i.e. `// stylist:ignore`, `# ...`)
+	// so that mentions of it in comment text or strings are ignored.
+	suppressionRegexp = regexp.MustCompile(
+		`(?:^|\s)(?://+|#+|/\*+|<!--|--|;+|%+)\s*` +
+			`stylist:ignore(-file)?(?:\[([^\]]*)\])?(?:[^\w-]|$)`,
+	)
+)
+
+// Suppression is an inline comment instructing stylist to ignore results.
+//
+// Line suppressions apply to results on the same line as the comment
+// and the line immediately following it. File suppressions apply to
+// every result in the file.
+type Suppression struct {
+	Path string
+	Line int
+	File bool
+	// Rules are in the form "source" or "source/rule-id".
+	// When empty, the suppression matches all results.
+	Rules []string
+	Text  string
+
+	used bool
+}
+
+// Matches returns true if the suppression applies to the result.
+func (s *Suppression) Matches(r *Result) bool {
+	if r.Location.Path != s.Path {
+		return false
+	}
+	if !s.File && r.Location.StartLine != s.Line && r.Location.StartLine != s.Line+1 {
+		return false
+	}
+	if len(s.Rules) == 0 {
+		return true
+	}
+	for _, rule := range s.Rules {
+		source, ruleID, hasRuleID := strings.Cut(rule, "/")
+		if source != r.Source {
+			continue
+		}
+		if !hasRuleID || ruleID == r.Rule.ID {
+			return true
+		}
+	}
+	return false
+}
+
+// Checked returns true if every processor the suppression targets is in
+// processors (i.e. it would have been used had any of them reported a
+// matching result). Suppressions without rules target any processor.
+func (s *Suppression) Checked(processors mapset.Set[string]) bool {
+	if len(s.Rules) == 0 {
+		return processors.Cardinality() > 0
+	}
+	for _, rule := range s.Rules {
+		source, _, _ := strings.Cut(rule, "/")
+		if !processors.Contains(source) {
+			return false
+		}
+	}
+	return true
+}
+
+// NewSuppressionScanner returns a new suppression scanner.
+// The path of buf (if set) is scanned using the contents of buf.
+func NewSuppressionScanner(buf *StdinBuffer) *SuppressionScanner {
+	return &SuppressionScanner{
+		lineCache: newLineCache(buf),
+	}
+}
+
+// SuppressionScanner finds suppression comments in files.
+type SuppressionScanner struct {
+	lineCache *fsutils.LineCache
+}
+
+// Scan returns all the suppressions in the file at path.
+func (s *SuppressionScanner) Scan(path string) ([]*Suppression, error) {
+	lines, err := s.lineCache.GetLines(path)
+	if err != nil {
+		return nil, err
+	}
+
+	suppressions := []*Suppression{}
+	for idx, line := range lines {
+		match := suppressionRegexp.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		suppression := &Suppression{
+			Path: path,
+			Line: idx + 1,
+			File: match[1] != "",
+			Text: "stylist:ignore" + match[1],
+		}
+		if strings.Contains(match[0], "[") {
+			suppression.Text += "[" + match[2] + "]"
+		}
+		for _, rule := range strings.Split(match[2], ",") {
+			rule = strings.TrimSpace(rule)
+			if rule != "" {
+				suppression.Rules = append(suppression.Rules, rule)
+			}
+		}
+		suppressions = append(suppressions, suppression)
+	}
+
+	return suppressions, nil
+}
@@ -0,0 +1,142 @@
+package stylist
+
+import (
+	"testing"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuppressionScanner_Scan(t *testing.T) {
+	scanner := NewSuppressionScanner(nil)
+
+	suppressions, err := scanner.Scan("testdata/suppressions/example.go")
+	assert.NoError(t, err)
+	assert.Equal(t, []*Suppression{
+		{
+			Path:  "testdata/suppressions/example.go",
+			Line:  3,
+			Rules: []string{"linter/rule-a"},
+			Text:  "stylist:ignore[linter/rule-a]",
+		},
+		{
+			Path:  "testdata/suppressions/example.go",
+			Line:  5,
+			Rules: []string{"linter"},
+			Text:  "stylist:ignore[linter]",
+		},
+		{
+			Path: "testdata/suppressions/example.go",
+			Line: 8,
+			Text: "stylist:ignore",
+		},
+		{
+			Path:  "testdata/suppressions/example.go",
+			Line:  10,
+			Rules: []string{"linter/rule-a", "other"},
+			Text:  "stylist:ignore[linter/rule-a, other]",
+		},
+		{
+			Path:  "testdata/suppressions/example.go",
+			Line:  18,
+			Rules: []string{"linter/rule-c"},
+			Text:  "stylist:ignore[linter/rule-c]",
+		},
+	}, suppressions)
+
+	suppressions, err = scanner.Scan("testdata/suppressions/ignored.go")
+	assert.NoError(t, err)
+	assert.Equal(t, []*Suppression{
+		{
+			Path:  "testdata/suppressions/ignored.go",
+			Line:  1,
+			File:  true,
+			Rules: []string{"linter/rule-b"},
+			Text:  "stylist:ignore-file[linter/rule-b]",
+		},
+	}, suppressions)
+
+	suppressions, err = scanner.Scan("testdata/suppressions/does-not-exist.go")
+	assert.ErrorContains(t, err, "no such file or directory")
+	assert.Nil(t, suppressions)
+}
+
+func TestSuppression_Checked(t *testing.T) {
+	processors := mapset.NewSet("linter", "other")
+
+	assert.True(t, (&Suppression{}).Checked(processors))
+	assert.False(t, (&Suppression{}).Checked(mapset.NewSet[string]()))
+	assert.True(t, (&Suppression{Rules: []string{"linter/rule-a", "other"}}).Checked(processors))
+	assert.False(t, (&Suppression{Rules: []string{"linter", "unknown/rule-a"}}).Checked(processors))
+}
+
+func TestSuppression_Matches(t *testing.T) {
+	newResult := func(path string, line int, source string, ruleID string) *Result {
+		return &Result{
+			Source:   source,
+			Location: ResultLocation{Path: path, StartLine: line},
+			Rule:     ResultRule{ID: ruleID},
+		}
+	}
+
+	tests := []struct {
+		desc        string
+		suppression *Suppression
+		result      *Result
+		expected    bool
+	}{
+		{
+			desc:        "matches all results on the same line when no rules",
+			suppression: &Suppression{Path: "a.go", Line: 3},
+			result:      newResult("a.go", 3, "linter", "rule-a"),
+			expected:    true,
+		},
+		{
+			desc:        "matches results on the following line",
+			suppression: &Suppression{Path: "a.go", Line: 3},
+			result:      newResult("a.go", 4, "linter", "rule-a"),
+			expected:    true,
+		},
+		{
+			desc:        "does not match results on other lines",
+			suppression: &Suppression{Path: "a.go", Line: 3},
+			result:      newResult("a.go", 5, "linter", "rule-a"),
+			expected:    false,
+		},
+		{
+			desc:        "does not match results in other files",
+			suppression: &Suppression{Path: "a.go", Line: 3},
+			result:      newResult("b.go", 3, "linter", "rule-a"),
+			expected:    false,
+		},
+		{
+			desc:        "file suppressions match any line",
+			suppression: &Suppression{Path: "a.go", Line: 1, File: true},
+			result:      newResult("a.go", 99, "linter", "rule-a"),
+			expected:    true,
+		},
+		{
+			desc:        "matches by source",
+			suppression: &Suppression{Path: "a.go", Line: 3, Rules: []string{"linter"}},
+			result:      newResult("a.go", 3, "linter", "rule-a"),
+			expected:    true,
+		},
+		{
+			desc:        "matches by source and rule id",
+			suppression: &Suppression{Path: "a.go", Line: 3, Rules: []string{"other", "linter/rule-a"}},
+			result:      newResult("a.go", 3, "linter", "rule-a"),
+			expected:    true,
+		},
+		{
+			desc:        "does not match other rule ids",
+			suppression: &Suppression{Path: "a.go", Line: 3, Rules: []string{"linter/rule-b"}},
+			result:      newResult("a.go", 3, "linter", "rule-a"),
+			expected:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.suppression.Matches(tt.result))
+		})
+	}
+}
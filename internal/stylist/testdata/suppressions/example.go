@@ -0,0 +1,18 @@
+package example
+
+func one() {} // stylist:ignore[linter/rule-a] known issue
+
+// stylist:ignore[linter]
+func two() {}
+
+func three() {} // stylist:ignore
+
+func four() {} /* stylist:ignore[linter/rule-a, other]*/
+
+// stylist:ignored is not a suppression
+func five() {}
+
+// Adding a stylist:ignore comment suppresses results.
+var six = "// stylist:ignore"
+
+var seven = "stylist:ignore" // stylist:ignore[linter/rule-c]
@@ -0,0 +1,2 @@
+// stylist:ignore-file[linter/rule-b] generated code
+package example
@@ -0,0 +1,77 @@
+// Package textdiff computes line-based diffs that can be partially applied.
+//
+// The go-diff package (used when parsing diff output) can only parse
+// and print unified diffs, so the diff itself is computed using go-difflib.
+package textdiff
+
+import (
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// Hunk represents a contiguous block of changed lines.
+type Hunk struct {
+	// 0-based index of the first original line replaced by this hunk.
+	OrigStart int
+	// Original lines removed by this hunk.
+	OrigLines []string
+	// 0-based index of the first new line added by this hunk.
+	NewStart int
+	// New lines added by this hunk.
+	NewLines []string
+}
+
+// SplitLines splits text into lines, retaining the line endings
+// so that joining the lines produces the original text.
+func SplitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// Lines returns the hunks needed to transform orig into updated.
+func Lines(orig []string, updated []string) []*Hunk {
+	// Disable the "popular line" heuristic; it produces
+	// noisy diffs for files w/ lots of repeated lines (blank lines, braces, etc).
+	matcher := difflib.NewMatcherWithJunk(orig, updated, false, nil)
+
+	var hunks []*Hunk
+	for _, op := range matcher.GetOpCodes() {
+		if op.Tag == 'e' {
+			continue
+		}
+		hunks = append(hunks, &Hunk{
+			OrigStart: op.I1,
+			OrigLines: subslice(orig, op.I1, op.I2),
+			NewStart:  op.J1,
+			NewLines:  subslice(updated, op.J1, op.J2),
+		})
+	}
+	return hunks
+}
+
+// Returns lines[i:j], or nil when empty.
+func subslice(lines []string, i int, j int) []string {
+	if i == j {
+		return nil
+	}
+	return lines[i:j]
+}
+
+// Apply applies the given subset of hunks (as returned by Lines) to orig.
+func Apply(orig []string, hunks []*Hunk) []string {
+	result := []string{}
+	next := 0
+	for _, hunk := range hunks {
+		result = append(result, orig[next:hunk.OrigStart]...)
+		result = append(result, hunk.NewLines...)
+		next = hunk.OrigStart + len(hunk.OrigLines)
+	}
+	return append(result, orig[next:]...)
+}
@@ -0,0 +1,67 @@
+package textdiff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitLines(t *testing.T) {
+	assert.Nil(t, SplitLines(""))
+	assert.Equal(t, []string{"one\n", "two\n"}, SplitLines("one\ntwo\n"))
+	assert.Equal(t, []string{"one\n", "two"}, SplitLines("one\ntwo"))
+}
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		desc     string
+		orig     string
+		updated  string
+		expected []*Hunk
+	}{
+		{
+			desc:     "returns nil when nothing changed",
+			orig:     "one\ntwo\n",
+			updated:  "one\ntwo\n",
+			expected: nil,
+		},
+		{
+			desc:    "returns a hunk for each changed block",
+			orig:    "one\ntwo\nthree\nfour\nfive\n",
+			updated: "one\nTWO\nthree\nfive\nsix\n",
+			expected: []*Hunk{
+				{OrigStart: 1, OrigLines: []string{"two\n"}, NewStart: 1, NewLines: []string{"TWO\n"}},
+				{OrigStart: 3, OrigLines: []string{"four\n"}, NewStart: 3},
+				{OrigStart: 5, NewStart: 4, NewLines: []string{"six\n"}},
+			},
+		},
+		{
+			desc:    "handles missing trailing newlines",
+			orig:    "one\ntwo",
+			updated: "one\ntwo\n",
+			expected: []*Hunk{
+				{OrigStart: 1, OrigLines: []string{"two"}, NewStart: 1, NewLines: []string{"two\n"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			hunks := Lines(SplitLines(tt.orig), SplitLines(tt.updated))
+			assert.Equal(t, tt.expected, hunks)
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	orig := SplitLines("one\ntwo\nthree\nfour\nfive\n")
+	updated := SplitLines("one\nTWO\nthree\nfive\nsix\n")
+	hunks := Lines(orig, updated)
+
+	assert.Equal(t, strings.Join(updated, ""), strings.Join(Apply(orig, hunks), ""))
+	assert.Equal(t, strings.Join(orig, ""), strings.Join(Apply(orig, nil), ""))
+	assert.Equal(t,
+		"one\nTWO\nthree\nfour\nfive\nsix\n",
+		strings.Join(Apply(orig, []*Hunk{hunks[0], hunks[2]}), ""),
+	)
+}
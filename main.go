@@ -20,11 +20,11 @@ func main() {
 	app, err := stylist.NewApp(meta)
 	if err != nil {
 		fmt.Println(err)
-		os.Exit(1)
+		os.Exit(cmd.ExitCodeFailure)
 	}
 	command := cmd.NewRootCmd(app)
 	ctx := app.InitContext(context.Background())
 	if err := command.ExecuteContext(ctx); err != nil {
-		os.Exit(1)
+		os.Exit(cmd.ExitCode(err))
 	}
 }